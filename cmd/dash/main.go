@@ -25,7 +25,8 @@ func main() {
 	}
 
 	if err := dash.CheckFile(schema, os.Args[1]); err != nil {
-		panic(err)
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
 	}
 
 	fmt.Println("ok!")
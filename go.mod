@@ -10,6 +10,7 @@ require (
 	github.com/kr/pretty v0.3.1
 	github.com/pkg/errors v0.9.1
 	github.com/smacker/go-tree-sitter v0.0.0-20230501083651-a7d92773b3aa
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -0,0 +1,98 @@
+package dash
+
+import "testing"
+
+func TestArguments(t *testing.T) {
+	runCheckCases(t, []checkCase{
+		{
+			Name: "positional arguments to a function",
+			Src: `pub add(x: Int!, y: Int!): Int! { x + y }
+pub i: Int! = add(1, 2)`,
+		},
+		{
+			Name: "named arguments to a function",
+			Src: `pub add(x: Int!, y: Int!): Int! { x + y }
+pub i: Int! = add(y: 2, x: 1)`,
+		},
+		{
+			Name: "positional then named arguments",
+			Src: `pub sub(x: Int!, y: Int!): Int! { x - y }
+pub i: Int! = sub(1, y: 2)`,
+		},
+		{
+			Name: "positional argument of the wrong type",
+			Src: `pub add(x: Int!, y: Int!): Int! { x + y }
+pub i = add(1, "two")`,
+			Err: `"y" cannot unify`,
+		},
+		{
+			Name: "positional arguments to a schema field",
+			Src:  `pub c = container.from("alpine").with-env-variable("A", "B")`,
+		},
+		{
+			Name: "positional argument followed by a default",
+			Src:  `pub c = container.with-exposed-port(8080)`,
+		},
+		{
+			Name: "positional after named",
+			Src: `pub add(x: Int!, y: Int!): Int! { x + y }
+pub i = add(x: 1, 2)`,
+			Err: "positional argument after named argument",
+		},
+		{
+			Name: "too many arguments",
+			Src: `pub add(x: Int!, y: Int!): Int! { x + y }
+pub i = add(1, 2, 3)`,
+			Err: "too many arguments; expected at most 2",
+		},
+		{
+			Name: "too many arguments to a schema field",
+			Src:  `pub c = container.from("alpine", "debian")`,
+			Err:  "too many arguments; expected at most 1",
+		},
+		{
+			Name: "positional and named argument for the same name",
+			Src: `pub add(x: Int!, y: Int!): Int! { x + y }
+pub i = add(1, x: 2)`,
+			Err: `argument "x" given more than once`,
+		},
+		{
+			Name: "named argument given twice",
+			Src: `pub add(x: Int!, y: Int!): Int! { x + y }
+pub i = add(x: 1, x: 2, y: 3)`,
+			Err: `argument "x" given more than once`,
+		},
+		{
+			Name: "missing argument",
+			Src: `pub add(x: Int!, y: Int!): Int! { x + y }
+pub i = add(1)`,
+			Err: `missing required argument "y"`,
+		},
+		{
+			Name: "missing arguments",
+			Src: `pub add(x: Int!, y: Int!): Int! { x + y }
+pub i = add()`,
+			Err: `missing required arguments "x" and "y"`,
+		},
+		{
+			Name: "unknown named argument",
+			Src: `pub add(x: Int!, y: Int!): Int! { x + y }
+pub i = add(1, z: 2)`,
+			Err: `"z" not found`,
+		},
+		{
+			Name: "positional arguments to a lambda",
+			Src: `pub add = fn(x, y) -> x + y
+pub i: Int! = add(1, 2)`,
+		},
+		{
+			Name: "positional arguments to a constructor",
+			Src: `cls Point {
+  pub x: Int!
+  pub y: Int!
+}
+pub p = Point(1, 2)`,
+			Err: "takes named arguments only",
+		},
+	})
+}
@@ -1,6 +1,7 @@
 package dash
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/chewxy/hm"
@@ -9,6 +10,7 @@ import (
 type Node interface {
 	hm.Expression
 	hm.Inferer
+	GetSourceLocation() *SourceLocation
 }
 
 type Keyed[X any] struct {
@@ -24,58 +26,125 @@ const (
 )
 
 type FunCall struct {
-	Fun  Node
+	Fun Node
+	// Args are the arguments in the order they were passed. Positional
+	// arguments have an empty Key.
 	Args Record
+	Loc  *SourceLocation
 }
 
 var _ Node = FunCall{}
 
 func (c FunCall) Body() hm.Expression { return c.Args }
 
+func (c FunCall) GetSourceLocation() *SourceLocation { return c.Loc }
+
 func (c FunCall) Infer(env hm.Env, fresh hm.Fresher) (hm.Type, error) {
-	fun, err := c.Fun.Infer(env, fresh)
+	return WithInferErrorHandling(c, func() (hm.Type, error) {
+		return c.infer(env, fresh)
+	})
+}
+
+func (c FunCall) infer(env hm.Env, fresh hm.Fresher) (hm.Type, error) {
+	var fun hm.Type
+	var err error
+	if callee, ok := c.Fun.(Callee); ok {
+		var field bool
+		fun, field, err = callee.InferCallee(env, fresh)
+		if err == nil && field && len(c.Args) == 0 {
+			if _, ok := fun.(*hm.FunctionType); !ok {
+				// fields without args are values, but () is still allowed
+				return fun, nil
+			}
+		}
+	} else {
+		fun, err = c.Fun.Infer(env, fresh)
+	}
 	if err != nil {
 		return nil, err
 	}
 
 	switch ft := fun.(type) {
 	case *hm.FunctionType:
-		for _, arg := range c.Args {
-			k, v := arg.Key, arg.Value
+		params := ft.Arg().(*RecordType)
 
-			it, err := v.Infer(env, fresh)
-			if err != nil {
-				return nil, fmt.Errorf("FunCall.Infer: %w", err)
-			}
+		// report every bad argument at once, rather than one per attempt
+		var errs []error
 
-			scheme, has := ft.Arg().(*RecordType).SchemeOf(k)
+		args, bindErr := c.bindArgs(params)
+		if bindErr != nil {
+			errs = append(errs, bindErr)
+		}
+		given := Set[string]{}
+		for _, arg := range args {
+			k, v := arg.Key, arg.Value
+			given[k] = struct{}{}
+
+			scheme, has := params.SchemeOf(k)
 			if !has {
-				return nil, fmt.Errorf("FunCall.Infer: %q not found in %s", k, ft.Arg())
+				errs = append(errs, NewInferError(fmt.Errorf("FunCall.Infer: %q not found in %s%s", k, params, didYouMean(k, hasScheme(params))), v))
+				continue
 			}
 
 			dt, isMono := scheme.Type()
 			if !isMono {
 				return nil, fmt.Errorf("FunCall.Infer: %q is not monomorphic", k)
 			}
+			// earlier arguments may have determined the type, e.g. the element
+			// type of a list that a lambda is applied to
+			dt = apply(fresh, dt)
+
+			v = expectType(env, v, dt)
+			if lambda, ok := v.(FunDecl); ok && lambda.Named == "" {
+				if eft, ok := dt.(*hm.FunctionType); ok {
+					v = lambda.expecting(eft)
+				}
+			}
 
-			if _, err := hm.Unify(dt, it); err != nil {
-				return nil, fmt.Errorf("FunCall.Infer: %q cannot unify (%s ~ %s): %w", k, dt, it, err)
+			it, err := v.Infer(env, fresh)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("FunCall.Infer: %w", err))
+				continue
+			}
+			it = widen(dt, it)
+
+			if err := unify(fresh, dt, it); err != nil {
+				doc, _ := params.DocOf(k)
+				errs = append(errs, withDoc(NewInferError(fmt.Errorf("FunCall.Infer: %q cannot unify (%s ~ %s): %w", k, dt, it, err), v), k, doc))
 			}
 		}
-		// TODO: check required args are specified?
-		return ft.Ret(false), nil
+
+		var missing []string
+		for _, f := range params.Fields {
+			if _, ok := given[f.Key]; !ok && !params.Optional(f.Key) {
+				missing = append(missing, f.Key)
+			}
+		}
+		if len(missing) > 0 && bindErr == nil {
+			// arguments that failed to bind would be reported as missing too
+			errs = append(errs, c.missingArgs(params, missing))
+		}
+
+		if len(errs) > 0 {
+			return nil, errors.Join(errs...)
+		}
+		return apply(fresh, ft.Ret(false)), nil
 	case *Module:
+		var errs []error
+		given := Set[string]{}
 		for _, arg := range c.Args {
 			k, v := arg.Key, arg.Value
 
-			it, err := v.Infer(env, fresh)
-			if err != nil {
-				return nil, fmt.Errorf("FunCall.Infer: %w", err)
+			if k == "" {
+				errs = append(errs, NewInferError(fmt.Errorf("FunCall.Infer: %s takes named arguments only", ft), v))
+				continue
 			}
+			given[k] = struct{}{}
 
 			scheme, has := ft.SchemeOf(k)
 			if !has {
-				return nil, fmt.Errorf("FunCall.Infer: %q not found in %s", k, ft)
+				errs = append(errs, NewInferError(fmt.Errorf("FunCall.Infer: %q not found in %s%s", k, ft, didYouMean(k, hasScheme(ft))), v))
+				continue
 			}
 
 			dt, isMono := scheme.Type()
@@ -83,43 +152,204 @@ func (c FunCall) Infer(env hm.Env, fresh hm.Fresher) (hm.Type, error) {
 				return nil, fmt.Errorf("FunCall.Infer: %q is not monomorphic", k)
 			}
 
-			if _, err := hm.Unify(dt, it); err != nil {
-				return nil, fmt.Errorf("FunCall.Infer: %q cannot unify (%s ~ %s): %w", k, dt, it, err)
+			v = expectType(env, v, dt)
+			it, err := v.Infer(env, fresh)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("FunCall.Infer: %w", err))
+				continue
+			}
+			it = widen(dt, it)
+
+			if err := unify(fresh, dt, it); err != nil {
+				doc, _ := ft.DocOf(k)
+				errs = append(errs, withDoc(NewInferError(fmt.Errorf("FunCall.Infer: %q cannot unify (%s ~ %s): %w", k, dt, it, err), v), k, doc))
 			}
 		}
+
+		var missing []string
+		for _, name := range ft.Required() {
+			if _, ok := given[name]; !ok {
+				missing = append(missing, name)
+			}
+		}
+		if len(missing) > 0 {
+			errs = append(errs, c.missingArgs(ft, missing))
+		}
+
+		if len(errs) > 0 {
+			return nil, errors.Join(errs...)
+		}
 		return NonNullType{ft}, nil
+	case hm.TypeVariable:
+		return c.inferVarCall(env, fresh, ft)
 	default:
 		return nil, fmt.Errorf("FunCall.Infer: expected function, got %s (%T)", fun, fun)
 	}
 }
 
+// inferVarCall infers a call to a function whose type isn't known yet, like g
+// in fn(g) -> g(1), by unifying it with a function of the arguments' types.
+// Positional arguments are named after their position, as _1, _2, etc., since
+// records unify by position anyway.
+func (c FunCall) inferVarCall(env hm.Env, fresh hm.Fresher, fun hm.TypeVariable) (hm.Type, error) {
+	var errs []error
+	params := NewRecordType("")
+	for i, arg := range c.Args {
+		it, err := arg.Value.Infer(env, fresh)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("FunCall.Infer: %w", err))
+			continue
+		}
+		key := arg.Key
+		if key == "" {
+			key = fmt.Sprintf("_%d", i+1)
+		}
+		params.Add(key, hm.NewScheme(nil, it))
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	ret := fresh.Fresh()
+	if err := unify(fresh, fun, hm.NewFnType(params, ret)); err != nil {
+		return nil, NewInferError(fmt.Errorf("FunCall.Infer: cannot call %s: %w", apply(fresh, fun), err), c.Fun)
+	}
+	return apply(fresh, ret), nil
+}
+
+// missingArgs returns an error for required arguments that were not passed.
+func (c FunCall) missingArgs(params interface{ DocOf(string) (string, bool) }, missing []string) error {
+	names := make([]string, len(missing))
+	for i, name := range missing {
+		names[i] = fmt.Sprintf("%q", name)
+	}
+	noun := "argument"
+	if len(missing) > 1 {
+		noun += "s"
+	}
+	err := NewInferError(fmt.Errorf("FunCall.Infer: missing required %s %s", noun, listJoin(names, ", ", "and")), c)
+	if len(missing) == 1 {
+		doc, _ := params.DocOf(missing[0])
+		return withDoc(err, missing[0], doc)
+	}
+	return err
+}
+
+// bindArgs names the positional arguments after the function's arguments, in
+// the order they are declared.
+func (c FunCall) bindArgs(args *RecordType) (Record, error) {
+	var errs []error
+	bound := make(Record, 0, len(c.Args))
+	given := map[string]bool{}
+	named := false
+	for i, arg := range c.Args {
+		k, v := arg.Key, arg.Value
+		if k == "" {
+			if named {
+				errs = append(errs, NewInferError(fmt.Errorf("FunCall.Infer: positional argument after named argument"), v))
+				continue
+			}
+			if i >= len(args.Fields) {
+				errs = append(errs, NewInferError(fmt.Errorf("FunCall.Infer: too many arguments; expected at most %d", len(args.Fields)), v))
+				continue
+			}
+			k = args.Fields[i].Key
+		} else {
+			named = true
+		}
+		if given[k] {
+			errs = append(errs, NewInferError(fmt.Errorf("FunCall.Infer: argument %q given more than once", k), v))
+			continue
+		}
+		given[k] = true
+		bound = append(bound, Keyed[Node]{k, v})
+	}
+	return bound, errors.Join(errs...)
+}
+
 var _ hm.Apply = FunCall{}
 
+// Callee is implemented by nodes that implicitly call functions that can be
+// called without arguments, like GraphQL fields. FunCall uses InferCallee to
+// infer the function itself, and whether it is a field of the schema.
+type Callee interface {
+	InferCallee(hm.Env, hm.Fresher) (hm.Type, bool, error)
+}
+
+// autoCall returns the return type of a function that can be called without
+// arguments, or the type as-is otherwise.
+func autoCall(t hm.Type) hm.Type {
+	ft, ok := t.(*hm.FunctionType)
+	if !ok {
+		return t
+	}
+	args, ok := ft.Arg().(*RecordType)
+	if !ok {
+		return t
+	}
+	for _, f := range args.Fields {
+		if !args.Optional(f.Key) {
+			return t
+		}
+	}
+	return ft.Ret(false)
+}
+
 func (c FunCall) Fn() hm.Expression { return c.Fun }
 
 type FunDecl struct {
-	Named      string
-	Args       []SlotDecl
-	Form       Node
-	Ret        TypeNode
-	Visibility Visibility
+	Named       string
+	Args        []SlotDecl
+	Form        Node
+	Ret         TypeNode
+	Visibility  Visibility
+	Description string // from the comment preceding it
+	Loc         *SourceLocation
 }
 
-var _ hm.Expression = FunDecl{}
+var _ Node = FunDecl{}
 
 func (f FunDecl) Body() hm.Expression { return f.Form }
 
-var _ hm.Inferer = FunDecl{}
+func (f FunDecl) GetSourceLocation() *SourceLocation { return f.Loc }
 
 func (f FunDecl) Infer(env hm.Env, fresh hm.Fresher) (hm.Type, error) {
-	// TODO: Lambda semantics
+	return WithInferErrorHandling(f, func() (hm.Type, error) {
+		return f.infer(env, fresh)
+	})
+}
+
+// expecting returns the lambda with its untyped arguments typed after the
+// arguments of the function type it is passed as, in order, so that their
+// usage can be checked, e.g. ctr.with(fn(c) -> c.withWorkdir(path: "/src")).
+func (f FunDecl) expecting(ft *hm.FunctionType) FunDecl {
+	params, ok := ft.Arg().(*RecordType)
+	if !ok {
+		return f
+	}
+	args := make([]SlotDecl, len(f.Args))
+	copy(args, f.Args)
+	for i, arg := range args {
+		if arg.Type_ != nil || arg.Value != nil || i >= len(params.Fields) {
+			continue
+		}
+		if t, mono := params.Fields[i].Value.Type(); mono {
+			args[i].Type_ = knownType{t, arg.Loc}
+		}
+	}
+	f.Args = args
+	return f
+}
 
+func (f FunDecl) infer(env hm.Env, fresh hm.Fresher) (hm.Type, error) {
 	var err error
 
-	// closure
+	// close over the enclosing scope
 	env = env.Clone()
 
 	args := []Keyed[*hm.Scheme]{}
+	defaults := Set[string]{}
+	docs := map[string]string{}
 	for _, arg := range f.Args {
 		var definedArgType hm.Type
 
@@ -133,6 +363,9 @@ func (f FunDecl) Infer(env hm.Env, fresh hm.Fresher) (hm.Type, error) {
 
 		var inferredValType hm.Type
 		if arg.Value != nil {
+			if definedArgType != nil {
+				arg.Value = expectType(env, arg.Value, definedArgType)
+			}
 			inferredValType, err = arg.Value.Infer(env, fresh)
 			if err != nil {
 				return nil, fmt.Errorf("FuncDecl.Infer arg: %w", err)
@@ -140,20 +373,27 @@ func (f FunDecl) Infer(env hm.Env, fresh hm.Fresher) (hm.Type, error) {
 		}
 
 		if definedArgType != nil && inferredValType != nil {
-			if !definedArgType.Eq(inferredValType) {
-				return nil, fmt.Errorf("FuncDecl.Infer arg: %q mismatch: defined as %s, inferred as %s", arg.Named, definedArgType, inferredValType)
+			if err := unify(fresh, definedArgType, widen(definedArgType, inferredValType)); err != nil {
+				return nil, NewInferError(fmt.Errorf("FuncDecl.Infer arg: %q mismatch: defined as %s, inferred as %s", arg.Named, definedArgType, inferredValType), arg)
 			}
 		} else if definedArgType != nil {
 			inferredValType = definedArgType
 		} else if inferredValType != nil {
 			definedArgType = inferredValType
 		} else {
-			return nil, fmt.Errorf("FuncDecl.Infer arg: %q has no type or value", arg.Named)
+			// lambda args may leave their type to be inferred from usage
+			definedArgType = fresh.Fresh()
 		}
 
 		scheme := hm.NewScheme(nil, definedArgType)
 		env.Add(arg.Named, scheme)
 		args = append(args, Keyed[*hm.Scheme]{arg.Named, scheme})
+		if arg.Value != nil {
+			defaults[arg.Named] = struct{}{}
+		}
+		if arg.Description != "" {
+			docs[arg.Named] = arg.Description
+		}
 	}
 
 	var definedRet hm.Type
@@ -171,22 +411,39 @@ func (f FunDecl) Infer(env hm.Env, fresh hm.Fresher) (hm.Type, error) {
 	}
 
 	if definedRet != nil {
-		// TODO: Unify?
-		if !definedRet.Eq(inferredRet) {
+		inferredRet = widen(definedRet, inferredRet)
+		if err := unify(fresh, definedRet, inferredRet); err != nil {
+			inferredRet = apply(fresh, inferredRet)
+			if f.Named == "" {
+				return nil, fmt.Errorf("FuncDecl.Infer: lambda mismatch: defined as %s, inferred as %s", definedRet, inferredRet)
+			}
 			return nil, fmt.Errorf("FuncDecl.Infer: %q mismatch: defined as %s, inferred as %s", f.Named, definedRet, inferredRet)
 		}
 	}
 
-	return hm.NewFnType(NewRecordType("", args...), inferredRet), nil
+	rt := NewRecordType("", args...)
+	rt.Defaults = defaults
+	rt.Docs = docs
+	return apply(fresh, hm.NewFnType(rt, inferredRet)), nil
 }
 
 type List struct {
 	Elements []Node
+	Element  hm.Type // the element type it is passed as, if any
+	Loc      *SourceLocation
 }
 
 var _ Node = List{}
 
+func (l List) GetSourceLocation() *SourceLocation { return l.Loc }
+
 func (l List) Infer(env hm.Env, f hm.Fresher) (hm.Type, error) {
+	return WithInferErrorHandling(l, func() (hm.Type, error) {
+		return l.infer(env, f)
+	})
+}
+
+func (l List) infer(env hm.Env, f hm.Fresher) (hm.Type, error) {
 	if len(l.Elements) == 0 {
 		// TODO: is this right?
 		return NonNullType{ListType{f.Fresh()}}, nil
@@ -198,14 +455,23 @@ func (l List) Infer(env hm.Env, f hm.Fresher) (hm.Type, error) {
 		if err != nil {
 			return nil, err
 		}
+		if l.Element != nil {
+			// e.g. an Int! element of a [Float!]!
+			et = widen(l.Element, et)
+		}
 		if t == nil {
 			t = et
-		} else if _, err := hm.Unify(t, et); err != nil {
-			// TODO: is this right?
-			return nil, fmt.Errorf("unify index %d: %w", i, err)
+			continue
+		}
+		// like the branches of a conditional, the list may mix null and
+		// non-null elements
+		ut, err := unifyBranches(f, t, et)
+		if err != nil {
+			return nil, NewInferError(fmt.Errorf("List.Infer: element %d has type %s, expected %s", i, apply(f, et), apply(f, t)), el)
 		}
+		t = ut
 	}
-	return NonNullType{ListType{t}}, nil
+	return NonNullType{ListType{apply(f, t)}}, nil
 }
 
 func (l List) Body() hm.Expression { return l }
@@ -214,17 +480,38 @@ func (l List) Body() hm.Expression { return l }
 
 type Symbol struct {
 	Name string
+	Loc  *SourceLocation
 }
 
 var _ Node = Symbol{}
 
+func (s Symbol) GetSourceLocation() *SourceLocation { return s.Loc }
+
 func (s Symbol) Infer(env hm.Env, fresh hm.Fresher) (hm.Type, error) {
-	scheme, found := env.SchemeOf(s.Name)
-	if !found {
-		return nil, fmt.Errorf("Symbol.Infer: %q not found in env", s.Name)
+	t, _, err := s.InferCallee(env, fresh)
+	if err != nil {
+		return nil, err
 	}
-	t, _ := scheme.Type()
-	return t, nil
+	return autoCall(t), nil
+}
+
+var _ Callee = Symbol{}
+
+func (s Symbol) InferCallee(env hm.Env, fresh hm.Fresher) (hm.Type, bool, error) {
+	mod, ok := env.(*Module)
+	field := ok && mod.IsField(s.Name)
+	t, err := WithInferErrorHandling(s, func() (hm.Type, error) {
+		scheme, found := env.SchemeOf(s.Name)
+		if !found {
+			return nil, fmt.Errorf("Symbol.Infer: %q not found in env%s", s.Name, didYouMean(s.Name, hasScheme(env)))
+		}
+		t := instantiate(fresh, scheme)
+		if _, failed := t.(errorType); failed {
+			return nil, errSuppressed
+		}
+		return t, nil
+	})
+	return t, field, err
 }
 
 func (s Symbol) Body() hm.Expression { return s }
@@ -232,32 +519,61 @@ func (s Symbol) Body() hm.Expression { return s }
 type Select struct {
 	Receiver Node
 	Field    string
+	Loc      *SourceLocation // location of the field name
 }
 
 var _ Node = Select{}
 
+func (d Select) GetSourceLocation() *SourceLocation { return d.Loc }
+
 func (d Select) Infer(env hm.Env, fresh hm.Fresher) (hm.Type, error) {
-	lt, err := d.Receiver.Infer(env, fresh)
+	t, _, err := d.InferCallee(env, fresh)
 	if err != nil {
 		return nil, err
 	}
+	return autoCall(t), nil
+}
+
+var _ Callee = Select{}
+
+func (d Select) InferCallee(env hm.Env, fresh hm.Fresher) (hm.Type, bool, error) {
+	var field bool
+	t, err := WithInferErrorHandling(d, func() (hm.Type, error) {
+		t, isField, err := d.infer(env, fresh)
+		field = isField
+		return t, err
+	})
+	return t, field, err
+}
+
+func (d Select) infer(env hm.Env, fresh hm.Fresher) (hm.Type, bool, error) {
+	lt, err := d.Receiver.Infer(env, fresh)
+	if err != nil {
+		return nil, false, err
+	}
+	lt = apply(fresh, lt)
+	if class, ok := lt.(*Module); ok {
+		// self and the class name are bound to the class itself, since they may
+		// also be called like a constructor
+		lt = NonNullType{class}
+	}
 	nn, ok := lt.(NonNullType)
 	if !ok {
-		return nil, fmt.Errorf("Select.Infer: expected %T, got %T", nn, lt)
+		return nil, false, fmt.Errorf("Select.Infer: expected %T, got %T", nn, lt)
+	}
+	if rt, ok := nn.Type.(*RecordType); ok {
+		t, err := d.inferRecord(env, fresh, rt)
+		return t, false, err
 	}
 	rec, ok := nn.Type.(*Module)
 	if !ok {
-		return nil, fmt.Errorf("Select.Infer: expected %T, got %T", rec, nn.Type)
+		return nil, false, fmt.Errorf("Select.Infer: expected %T, got %T", rec, nn.Type)
 	}
 	scheme, found := rec.SchemeOf(d.Field)
 	if !found {
-		return nil, fmt.Errorf("Select.Infer: field %q not found in record %s", d.Field, rec)
+		return nil, false, fmt.Errorf("Select.Infer: field %q not found in record %s%s", d.Field, rec, didYouMean(d.Field, hasScheme(rec)))
 	}
-	t, mono := scheme.Type()
-	if !mono {
-		return nil, fmt.Errorf("Select.Infer: type of field %q is not monomorphic", d.Field)
-	}
-	return t, nil
+	return instantiate(fresh, scheme), rec.IsField(d.Field), nil
 }
 
 func (d Select) Body() hm.Expression { return d }
@@ -265,11 +581,20 @@ func (d Select) Body() hm.Expression { return d }
 type Default struct {
 	Left  Node
 	Right Node
+	Loc   *SourceLocation
 }
 
 var _ Node = Default{}
 
+func (d Default) GetSourceLocation() *SourceLocation { return d.Loc }
+
 func (d Default) Infer(env hm.Env, fresh hm.Fresher) (hm.Type, error) {
+	return WithInferErrorHandling(d, func() (hm.Type, error) {
+		return d.infer(env, fresh)
+	})
+}
+
+func (d Default) infer(env hm.Env, fresh hm.Fresher) (hm.Type, error) {
 	lt, err := d.Left.Infer(env, fresh)
 	if err != nil {
 		return nil, err
@@ -278,67 +603,423 @@ func (d Default) Infer(env hm.Env, fresh hm.Fresher) (hm.Type, error) {
 	if err != nil {
 		return nil, err
 	}
-	lt = NonNullType{lt}
-	if !lt.Eq(rt) {
-		return nil, fmt.Errorf("Default.Infer: mismatched types: %s != %s", lt, rt)
+	// the left side is narrowed to non-null, and so is the result if the right
+	// side is non-null
+	lt = NonNullType{stripNonNull(apply(fresh, lt))}
+	rt = widen(lt, apply(fresh, rt))
+	if rnn, ok := rt.(NonNullType); ok {
+		if err := unify(fresh, lt, rnn); err != nil {
+			return nil, fmt.Errorf("Default.Infer: mismatched types: %s != %s", apply(fresh, lt), apply(fresh, rt))
+		}
+		return apply(fresh, lt), nil
+	}
+	if err := unify(fresh, stripNonNull(lt), rt); err != nil {
+		return nil, fmt.Errorf("Default.Infer: mismatched types: %s != %s", apply(fresh, lt), apply(fresh, rt))
 	}
-	return rt, nil
+	return apply(fresh, rt), nil
 }
 
 func (d Default) Body() hm.Expression { return d }
 
-type Null struct{}
+// Conditional is an if/else expression. Without an else branch, its value is
+// null when the condition is false.
+type Conditional struct {
+	Condition Node
+	Then      Block
+	Else      Node // Block, Conditional, or nil
+	Loc       *SourceLocation
+}
+
+var _ Node = Conditional{}
+
+func (c Conditional) Body() hm.Expression { return c.Then }
+
+func (c Conditional) GetSourceLocation() *SourceLocation { return c.Loc }
+
+func (c Conditional) Infer(env hm.Env, fresh hm.Fresher) (hm.Type, error) {
+	return WithInferErrorHandling(c, func() (hm.Type, error) {
+		return c.infer(env, fresh)
+	})
+}
+
+func (c Conditional) infer(env hm.Env, fresh hm.Fresher) (hm.Type, error) {
+	bool_, err := NonNullTypeNode{NamedTypeNode{"Boolean", c.Loc}, c.Loc}.Infer(env, fresh)
+	if err != nil {
+		return nil, err
+	}
+
+	var errs []error
+
+	ct, err := c.Condition.Infer(env, fresh)
+	if err != nil {
+		errs = append(errs, err)
+	} else if err := unify(fresh, bool_, ct); err != nil {
+		errs = append(errs, NewInferError(fmt.Errorf("Conditional.Infer: condition must be %s, got %s", bool_, ct), c.Condition))
+	}
+
+	tt, err := c.Then.Infer(env, fresh)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	if c.Else == nil {
+		if len(errs) > 0 {
+			return nil, errors.Join(errs...)
+		}
+		// no else branch; null if the condition is false
+		if nn, ok := tt.(NonNullType); ok {
+			return nn.Type, nil
+		}
+		return tt, nil
+	}
+
+	et, err := c.Else.Infer(env, fresh)
+	if err != nil {
+		errs = append(errs, err)
+	} else if tt != nil {
+		t, err := unifyBranches(fresh, tt, et)
+		if err != nil {
+			errs = append(errs, NewInferError(fmt.Errorf("Conditional.Infer: %w", err), c.Else))
+		} else if len(errs) == 0 {
+			return t, nil
+		}
+	}
+
+	return nil, errors.Join(errs...)
+}
+
+// unifyBranches determines the type of a conditional from the types of its
+// branches. If only one branch is nullable, so is the result.
+func unifyBranches(fresh hm.Fresher, tt, et hm.Type) (hm.Type, error) {
+	tt, et = apply(fresh, tt), apply(fresh, et)
+	thenNull, elseNull := isNull(fresh, tt), isNull(fresh, et)
+	switch {
+	case thenNull && !elseNull:
+		// e.g. if x { null } else { y }
+		if nn, ok := et.(NonNullType); ok {
+			return nn.Type, nil
+		}
+		return et, nil
+	case elseNull && !thenNull:
+		if nn, ok := tt.(NonNullType); ok {
+			return nn.Type, nil
+		}
+		return tt, nil
+	}
+
+	if !isVar(tt) && !isVar(et) {
+		tnn, thenNonNull := tt.(NonNullType)
+		enn, elseNonNull := et.(NonNullType)
+		if thenNonNull && !elseNonNull {
+			tt = tnn.Type
+		} else if elseNonNull && !thenNonNull {
+			et = enn.Type
+		}
+	}
+
+	if err := unify(fresh, tt, et); err != nil {
+		return nil, fmt.Errorf("branches have mismatched types: %s != %s", tt, et)
+	}
+	return apply(fresh, tt), nil
+}
+
+// EnumValue is a value of an enum type written without the type, like TCP in
+// with-exposed-port(port: 80, protocol: TCP). Values are only written bare
+// where the enum is expected; elsewhere they're selected from the enum, like
+// NetworkProtocol.TCP.
+type EnumValue struct {
+	Enum  *Module
+	Value string
+	Loc   *SourceLocation
+}
+
+var _ Node = EnumValue{}
+
+func (e EnumValue) Body() hm.Expression { return e }
+
+func (e EnumValue) GetSourceLocation() *SourceLocation { return e.Loc }
+
+func (e EnumValue) Infer(env hm.Env, fresh hm.Fresher) (hm.Type, error) {
+	return WithInferErrorHandling(e, func() (hm.Type, error) {
+		values := e.Enum.EnumValues()
+		if !contains(values, e.Value) {
+			return nil, fmt.Errorf("EnumValue.Infer: %s is not a value of %s; expected %s", e.Value, e.Enum, listJoin(values, ", ", "or"))
+		}
+		return NonNullType{e.Enum}, nil
+	})
+}
+
+// expectType returns a literal as the type that is expected where it is
+// written, which its syntax alone doesn't determine: a bare word is a value of
+// the enum that is expected, unless the word is bound to something else, and
+// an object is the input object that is expected. The elements of a list are
+// treated likewise.
+func expectType(env hm.Env, node Node, expected hm.Type) Node {
+	switch n := node.(type) {
+	case Symbol:
+		enum, ok := stripNonNull(expected).(*Module)
+		if !ok || enum.EnumValues() == nil {
+			return node
+		}
+		if _, bound := env.SchemeOf(n.Name); bound {
+			return node
+		}
+		return EnumValue{enum, n.Name, n.Loc}
+	case Object:
+		if input, ok := stripNonNull(expected).(*RecordType); ok && input.Named != "" {
+			n.Input = input
+			return n
+		}
+	case List:
+		if lt, ok := stripNonNull(expected).(ListType); ok {
+			elements := make([]Node, len(n.Elements))
+			for i, el := range n.Elements {
+				elements[i] = expectType(env, el, lt.Type)
+			}
+			n.Elements = elements
+			n.Element = lt.Type
+			return n
+		}
+	}
+	return node
+}
+
+type Null struct {
+	Loc *SourceLocation
+}
 
 var _ Node = Null{}
 
 func (n Null) Body() hm.Expression { return n }
 
+func (n Null) GetSourceLocation() *SourceLocation { return n.Loc }
+
+// Null does not have a type. Its type is always inferred as a free variable,
+// which may only be bound to a nullable type.
 func (Null) Infer(env hm.Env, fresh hm.Fresher) (hm.Type, error) {
-	return fresh.Fresh(), nil
+	return freshNull(fresh), nil
 }
 
-var (
-	// Null does not have a type. Its type is always inferred as a free variable.
-	// NullType    = NewClass("Null")
-
-	BooleanType = NewModule("Boolean")
-	StringType  = NewModule("String")
-	IntType     = NewModule("Int")
-)
-
 type String struct {
 	Value string
+	Loc   *SourceLocation
 }
 
 var _ Node = String{}
 
 func (s String) Body() hm.Expression { return s }
 
+func (s String) GetSourceLocation() *SourceLocation { return s.Loc }
+
 func (s String) Infer(env hm.Env, fresh hm.Fresher) (hm.Type, error) {
-	return NonNullTypeNode{NamedTypeNode{"String"}}.Infer(env, fresh)
+	return WithInferErrorHandling(s, func() (hm.Type, error) {
+		return NonNullTypeNode{NamedTypeNode{"String", s.Loc}, s.Loc}.Infer(env, fresh)
+	})
+}
+
+// Interpolation is a string literal with expressions embedded in it, like
+// "golang:${version}-alpine". Each part is either a String or an expression
+// whose value is converted to a string.
+type Interpolation struct {
+	Parts []Node
+	Loc   *SourceLocation
+}
+
+var _ Node = Interpolation{}
+
+func (i Interpolation) Body() hm.Expression { return i }
+
+func (i Interpolation) GetSourceLocation() *SourceLocation { return i.Loc }
+
+// interpolatable is the set of types that can be embedded in a string.
+var interpolatable = []string{"String", "Int", "Float", "Boolean", "DirPath"}
+
+func (i Interpolation) Infer(env hm.Env, fresh hm.Fresher) (hm.Type, error) {
+	return WithInferErrorHandling(i, func() (hm.Type, error) {
+		str, err := NonNullTypeNode{NamedTypeNode{"String", i.Loc}, i.Loc}.Infer(env, fresh)
+		if err != nil {
+			return nil, err
+		}
+
+		var errs []error
+		for _, part := range i.Parts {
+			if _, ok := part.(String); ok {
+				continue
+			}
+			if err := inferInterpolated(env, fresh, part); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		if len(errs) > 0 {
+			return nil, errors.Join(errs...)
+		}
+
+		return str, nil
+	})
+}
+
+func inferInterpolated(env hm.Env, fresh hm.Fresher, part Node) error {
+	t, err := part.Infer(env, fresh)
+	if err != nil {
+		return err
+	}
+
+	if isNull(fresh, t) {
+		return NewInferError(fmt.Errorf("Interpolation.Infer: cannot interpolate null into a string"), part)
+	}
+
+	if _, ok := t.(hm.TypeVariable); ok {
+		str, err := NonNullTypeNode{NamedTypeNode{"String", nil}, nil}.Infer(env, fresh)
+		if err != nil {
+			return err
+		}
+		if err := unify(fresh, str, t); err != nil {
+			return NewInferError(fmt.Errorf("Interpolation.Infer: %w", err), part)
+		}
+		return nil
+	}
+
+	allowed := make([]string, len(interpolatable))
+	for i, name := range interpolatable {
+		it, err := NonNullTypeNode{NamedTypeNode{name, nil}, nil}.Infer(env, fresh)
+		if err != nil {
+			return err
+		}
+		if it.Eq(t) {
+			return nil
+		}
+		allowed[i] = it.Name()
+	}
+
+	return NewInferError(fmt.Errorf("Interpolation.Infer: cannot interpolate %s into a string; must be %s", t, listJoin(allowed, ", ", "or")), part)
 }
 
 type Quoted struct {
 	Quoter string
 	Raw    string
+	Loc    *SourceLocation
+}
+
+// FilePath is a path literal like ./main.go. Paths are passed around as
+// strings.
+type FilePath struct {
+	Path string
+	Loc  *SourceLocation
 }
 
-type Boolean bool
+var _ Node = FilePath{}
+
+func (p FilePath) Body() hm.Expression { return p }
+
+func (p FilePath) GetSourceLocation() *SourceLocation { return p.Loc }
+
+func (p FilePath) Infer(env hm.Env, fresh hm.Fresher) (hm.Type, error) {
+	return WithInferErrorHandling(p, func() (hm.Type, error) {
+		return NonNullTypeNode{NamedTypeNode{"String", p.Loc}, p.Loc}.Infer(env, fresh)
+	})
+}
+
+// DirPath is a path literal with a trailing slash, like ./src/. Its type is
+// DirPath!, which may be passed as a String!, so that only directories can be
+// joined onto.
+type DirPath struct {
+	Path string
+	Loc  *SourceLocation
+}
+
+var _ Node = DirPath{}
+
+func (p DirPath) Body() hm.Expression { return p }
 
-var _ Node = Boolean(false)
+func (p DirPath) GetSourceLocation() *SourceLocation { return p.Loc }
+
+func (p DirPath) Infer(env hm.Env, fresh hm.Fresher) (hm.Type, error) {
+	return WithInferErrorHandling(p, func() (hm.Type, error) {
+		return NonNullTypeNode{NamedTypeNode{"DirPath", p.Loc}, p.Loc}.Infer(env, fresh)
+	})
+}
+
+// Join appends a relative path to a directory path, like dir/./sub. Joining a
+// directory path results in a directory path, and joining a file path results
+// in a String!.
+type Join struct {
+	Dir  Node
+	Path Node
+	Loc  *SourceLocation
+}
+
+var _ Node = Join{}
+
+func (j Join) Body() hm.Expression { return j }
+
+func (j Join) GetSourceLocation() *SourceLocation { return j.Loc }
+
+func (j Join) Infer(env hm.Env, fresh hm.Fresher) (hm.Type, error) {
+	return WithInferErrorHandling(j, func() (hm.Type, error) {
+		dir, err := NonNullTypeNode{NamedTypeNode{"DirPath", j.Loc}, j.Loc}.Infer(env, fresh)
+		if err != nil {
+			return nil, err
+		}
+
+		dt, err := j.Dir.Infer(env, fresh)
+		if err != nil {
+			return nil, err
+		}
+		if err := unify(fresh, dir, dt); err != nil {
+			return nil, NewInferError(fmt.Errorf("Join.Infer: cannot join onto %s; expected a directory path like ./dir/", dt), j.Dir)
+		}
+
+		return j.Path.Infer(env, fresh)
+	})
+}
+
+type Boolean struct {
+	Value bool
+	Loc   *SourceLocation
+}
+
+var _ Node = Boolean{}
 
 func (b Boolean) Body() hm.Expression { return b }
 
+func (b Boolean) GetSourceLocation() *SourceLocation { return b.Loc }
+
 func (b Boolean) Infer(env hm.Env, fresh hm.Fresher) (hm.Type, error) {
-	return NonNullTypeNode{NamedTypeNode{"Boolean"}}.Infer(env, fresh)
+	return WithInferErrorHandling(b, func() (hm.Type, error) {
+		return NonNullTypeNode{NamedTypeNode{"Boolean", b.Loc}, b.Loc}.Infer(env, fresh)
+	})
 }
 
-type Int int
+type Int struct {
+	Value int64
+	Loc   *SourceLocation
+}
 
-var _ Node = Int(0)
+var _ Node = Int{}
 
 func (i Int) Body() hm.Expression { return i }
 
+func (i Int) GetSourceLocation() *SourceLocation { return i.Loc }
+
 func (i Int) Infer(env hm.Env, fresh hm.Fresher) (hm.Type, error) {
-	return NonNullTypeNode{NamedTypeNode{"Int"}}.Infer(env, fresh)
+	return WithInferErrorHandling(i, func() (hm.Type, error) {
+		return NonNullTypeNode{NamedTypeNode{"Int", i.Loc}, i.Loc}.Infer(env, fresh)
+	})
+}
+
+type Float struct {
+	Value float64
+	Loc   *SourceLocation
+}
+
+var _ Node = Float{}
+
+func (f Float) Body() hm.Expression { return f }
+
+func (f Float) GetSourceLocation() *SourceLocation { return f.Loc }
+
+func (f Float) Infer(env hm.Env, fresh hm.Fresher) (hm.Type, error) {
+	return WithInferErrorHandling(f, func() (hm.Type, error) {
+		return NonNullTypeNode{NamedTypeNode{"Float", f.Loc}, f.Loc}.Infer(env, fresh)
+	})
 }
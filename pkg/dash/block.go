@@ -8,12 +8,15 @@ import (
 
 type Block struct {
 	Forms []Node
+	Loc   *SourceLocation
 }
 
-var _ hm.Expression = Block{}
+var _ Node = Block{}
 
 func (f Block) Body() hm.Expression { return f }
 
+func (f Block) GetSourceLocation() *SourceLocation { return f.Loc }
+
 type Hoister interface {
 	Hoist(hm.Env, hm.Fresher, int) error
 }
@@ -39,17 +42,26 @@ var _ hm.Inferer = Block{}
 func (b Block) Infer(env hm.Env, fresh hm.Fresher) (hm.Type, error) {
 	forms := b.Forms
 	if len(forms) == 0 {
-		forms = append(forms, Null{})
+		forms = append(forms, Null{Loc: b.Loc})
 	}
 
+	// keep going after a form fails so that all errors are reported at once;
+	// failed slots are bound to errorType so that they don't cause follow-on
+	// errors
 	var t hm.Type
+	var errs []error
 	for _, form := range forms {
 		et, err := form.Infer(env, fresh)
 		if err != nil {
-			return nil, err
+			errs = append(errs, err)
+			continue
 		}
 		t = et
 	}
 
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
 	return t, nil
 }
@@ -0,0 +1,171 @@
+package dash
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/chewxy/hm"
+)
+
+// Case branches on the value of an enum or on whether a value is null:
+//
+//	case protocol {
+//	  TCP -> 1,
+//	  UDP -> 2,
+//	}
+//
+// The arms must cover every value of the enum, or else end in an else arm. A
+// nullable subject must also be handled by a null arm or an else arm. If the
+// subject is a symbol, the non-null arms see it as non-null.
+type Case struct {
+	Subject Node
+	Arms    []CaseArm
+	Loc     *SourceLocation
+}
+
+// CaseArm is a single arm of a Case, matching either an enum value, null, or
+// anything else.
+type CaseArm struct {
+	Value string // enum value; empty for null and else arms
+	Null  bool
+	Else  bool
+	Body  Node
+	Loc   *SourceLocation
+}
+
+var _ Node = Case{}
+
+func (c Case) Body() hm.Expression { return c.Subject }
+
+func (c Case) GetSourceLocation() *SourceLocation { return c.Loc }
+
+func (c Case) Infer(env hm.Env, fresh hm.Fresher) (hm.Type, error) {
+	return WithInferErrorHandling(c, func() (hm.Type, error) {
+		return c.infer(env, fresh)
+	})
+}
+
+func (c Case) infer(env hm.Env, fresh hm.Fresher) (hm.Type, error) {
+	st, err := c.Subject.Infer(env, fresh)
+	if err != nil {
+		return nil, err
+	}
+
+	inner := st
+	nn, nonNull := st.(NonNullType)
+	if nonNull {
+		inner = nn.Type
+	}
+
+	var values []string
+	if mod, ok := inner.(*Module); ok {
+		values = mod.EnumValues()
+	}
+
+	var errs []error
+
+	// check the arms against the subject, and for exhaustiveness
+	covered := map[string]bool{}
+	var hasNull, hasElse bool
+	for _, arm := range c.Arms {
+		if hasElse {
+			errs = append(errs, NewInferError(fmt.Errorf("Case.Infer: unreachable arm after else"), arm))
+			break
+		}
+		switch {
+		case arm.Else:
+			hasElse = true
+		case arm.Null:
+			if nonNull {
+				errs = append(errs, NewInferError(fmt.Errorf("Case.Infer: %s is never null", st), arm))
+			} else if hasNull {
+				errs = append(errs, NewInferError(fmt.Errorf("Case.Infer: duplicate null arm"), arm))
+			}
+			hasNull = true
+		default:
+			if values == nil {
+				errs = append(errs, NewInferError(fmt.Errorf("Case.Infer: cannot match %s against %s; only enums can be matched by value", arm.Value, st), arm))
+			} else if !contains(values, arm.Value) {
+				errs = append(errs, NewInferError(fmt.Errorf("Case.Infer: %s is not a value of %s; expected %s", arm.Value, inner, listJoin(values, ", ", "or")), arm))
+			} else if covered[arm.Value] {
+				errs = append(errs, NewInferError(fmt.Errorf("Case.Infer: duplicate arm for %s", arm.Value), arm))
+			}
+			covered[arm.Value] = true
+		}
+	}
+
+	if !hasElse {
+		var missing []string
+		for _, v := range values {
+			if !covered[v] {
+				missing = append(missing, v)
+			}
+		}
+		if values == nil {
+			// non-null values of anything other than an enum can only be
+			// handled by else
+			missing = append(missing, "else")
+		}
+		if !nonNull && !hasNull {
+			missing = append(missing, "null")
+		}
+		if len(missing) > 0 {
+			errs = append(errs, NewInferError(fmt.Errorf("Case.Infer: not all cases are handled; missing %s", listJoin(missing, ", ", "and")), c))
+		}
+	}
+
+	// narrow a nullable symbol in the arms that rule out null
+	narrowed := env
+	if sym, ok := c.Subject.(Symbol); ok && !nonNull {
+		if _, isVar := st.(hm.TypeVariable); !isVar {
+			narrowed = env.Clone()
+			narrowed.Add(sym.Name, hm.NewScheme(nil, NonNullType{st}))
+		}
+	}
+
+	var t hm.Type
+	for _, arm := range c.Arms {
+		armEnv := narrowed
+		if arm.Null {
+			armEnv = env
+		}
+		at, err := arm.Body.Infer(armEnv, fresh)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if t == nil {
+			t = at
+			continue
+		}
+		ut, err := unifyBranches(fresh, t, at)
+		if err != nil {
+			errs = append(errs, NewInferError(fmt.Errorf("Case.Infer: %w", err), arm.Body))
+			continue
+		}
+		t = ut
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	if t == nil {
+		return nil, fmt.Errorf("Case.Infer: no arms")
+	}
+
+	return t, nil
+}
+
+var _ Located = CaseArm{}
+
+func (a CaseArm) GetSourceLocation() *SourceLocation { return a.Loc }
+
+func contains(list []string, str string) bool {
+	for _, s := range list {
+		if s == str {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,134 @@
+package dash
+
+import "testing"
+
+func TestCase(t *testing.T) {
+	runCheckCases(t, []checkCase{
+		{
+			Name: "every value",
+			Src: `pub p: NetworkProtocol! = TCP
+pub x: Int! = case p { TCP -> 1, UDP -> 2 }`,
+		},
+		{
+			Name: "arms on separate lines",
+			Src: `pub p: NetworkProtocol! = TCP
+pub x: Int! = case p {
+  TCP -> 1
+  else -> 2
+}`,
+		},
+		{
+			Name: "missing value",
+			Src: `pub p: NetworkProtocol! = TCP
+pub x = case p { TCP -> 1 }`,
+			Err: "not all cases are handled; missing UDP",
+		},
+		{
+			Name: "else covers the rest",
+			Src: `pub p: NetworkProtocol! = TCP
+pub x: Int! = case p { TCP -> 1, else -> 2 }`,
+		},
+		{
+			Name: "arm after else",
+			Src: `pub p: NetworkProtocol! = TCP
+pub x = case p { else -> 2, TCP -> 1 }`,
+			Err: "unreachable arm after else",
+		},
+		{
+			Name: "unknown value",
+			Src: `pub p: NetworkProtocol! = TCP
+pub x = case p { TCP -> 1, UDP -> 2, SCTP -> 3 }`,
+			Err: "SCTP is not a value of NetworkProtocol; expected TCP or UDP",
+		},
+		{
+			Name: "duplicate value",
+			Src: `pub p: NetworkProtocol! = TCP
+pub x = case p { TCP -> 1, TCP -> 2, UDP -> 3 }`,
+			Err: "duplicate arm for TCP",
+		},
+		{
+			Name: "value of a non-enum",
+			Src:  `pub x = case 1 { TCP -> 1, else -> 2 }`,
+			Err:  "only enums can be matched by value",
+		},
+		{
+			Name: "non-enum with else",
+			Src:  `pub x: Int! = case "a" { else -> 1 }`,
+		},
+		{
+			Name: "non-enum without else",
+			Src: `pub maybe: String = null
+pub x = case maybe { null -> 1 }`,
+			Err: "not all cases are handled; missing else",
+		},
+		{
+			Name: "mismatched arms",
+			Src: `pub p: NetworkProtocol! = TCP
+pub x = case p { TCP -> 1, UDP -> "two" }`,
+			Err: "branches have mismatched types",
+		},
+		{
+			Name: "nullable subject without a null arm",
+			Src: `pub p: NetworkProtocol = null
+pub x = case p { TCP -> 1, UDP -> 2 }`,
+			Err: "not all cases are handled; missing null",
+		},
+		{
+			Name: "nullable subject with a null arm",
+			Src: `pub p: NetworkProtocol = null
+pub x: Int! = case p { TCP -> 1, UDP -> 2, null -> 0 }`,
+		},
+		{
+			Name: "nullable subject with else",
+			Src: `pub p: NetworkProtocol = null
+pub x: Int! = case p { TCP -> 1, else -> 0 }`,
+		},
+		{
+			Name: "null arm for a non-null subject",
+			Src: `pub p: NetworkProtocol! = TCP
+pub x = case p { TCP -> 1, UDP -> 2, null -> 0 }`,
+			Err: "NetworkProtocol! is never null",
+		},
+		{
+			Name: "duplicate null arm",
+			Src: `pub p: NetworkProtocol = null
+pub x = case p { null -> 0, null -> 1, else -> 2 }`,
+			Err: "duplicate null arm",
+		},
+		{
+			Name: "null arm makes the result nullable",
+			Src: `pub p: NetworkProtocol = null
+pub x: Int! = case p { null -> null, else -> 1 }`,
+			Err: "Int is nullable, but Int! is not",
+		},
+		{
+			Name: "subject is non-null in the other arms",
+			Src: `pub maybe: String = null
+pub x: String! = case maybe { null -> "none", else -> maybe }`,
+		},
+		{
+			Name: "subject is still nullable in the null arm",
+			Src: `pub maybe: String = null
+pub x: String! = case maybe { null -> maybe, else -> maybe }`,
+			Err: "String is nullable, but String! is not",
+		},
+		{
+			Name: "narrowing is limited to the arms",
+			Src: `pub maybe: String = null
+pub x = case maybe { null -> "none", else -> maybe }
+pub y: String! = maybe`,
+			Err: "String is nullable, but String! is not",
+		},
+		{
+			Name: "type variable arms",
+			Src: `pub pick = fn(p: NetworkProtocol!, a, b) -> case p { TCP -> a, UDP -> b }
+pub x: Int! = pick(TCP, 1, 2)`,
+		},
+		{
+			Name: "type variable arms with mismatched arguments",
+			Src: `pub pick = fn(p: NetworkProtocol!, a, b) -> case p { TCP -> a, UDP -> b }
+pub x = pick(TCP, 1, "s")`,
+			Err: `"b" cannot unify`,
+		},
+	})
+}
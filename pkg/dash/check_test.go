@@ -0,0 +1,180 @@
+package dash
+
+import (
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dagger/dagger/codegen/introspection"
+)
+
+func init() {
+	// NewEnv logs every field it installs
+	log.SetOutput(io.Discard)
+}
+
+// checkCase is a dash file and the error that checking it should report, or
+// "" if it should check. Files are written alongside it, e.g. to be imported.
+type checkCase struct {
+	Name  string
+	Src   string
+	Err   string
+	Files map[string]string
+}
+
+func runCheckCases(t *testing.T, cases []checkCase) {
+	t.Helper()
+	for _, c := range cases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			dir := t.TempDir()
+			for name, src := range c.Files {
+				path := filepath.Join(dir, name)
+				if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(path, []byte(src), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			path := filepath.Join(dir, "main.dash")
+			if err := os.WriteFile(path, []byte(c.Src), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			err := CheckFile(testSchema(), path)
+			switch {
+			case c.Err == "" && err != nil:
+				t.Errorf("expected no error, got:\n%s", err)
+			case c.Err != "" && err == nil:
+				t.Errorf("expected error containing %q, got none", c.Err)
+			case c.Err != "" && !strings.Contains(err.Error(), c.Err):
+				t.Errorf("expected error containing %q, got:\n%s", c.Err, err)
+			}
+		})
+	}
+}
+
+// testSchema is a small schema shaped like Dagger's.
+func testSchema() *introspection.Schema {
+	scalar := func(name string) *introspection.TypeRef {
+		return &introspection.TypeRef{Kind: introspection.TypeKindScalar, Name: name}
+	}
+	object := func(name string) *introspection.TypeRef {
+		return &introspection.TypeRef{Kind: introspection.TypeKindObject, Name: name}
+	}
+	enum := func(name string) *introspection.TypeRef {
+		return &introspection.TypeRef{Kind: introspection.TypeKindEnum, Name: name}
+	}
+	input := func(name string) *introspection.TypeRef {
+		return &introspection.TypeRef{Kind: introspection.TypeKindInputObject, Name: name}
+	}
+	nonNull := func(ref *introspection.TypeRef) *introspection.TypeRef {
+		return &introspection.TypeRef{Kind: introspection.TypeKindNonNull, OfType: ref}
+	}
+	list := func(ref *introspection.TypeRef) *introspection.TypeRef {
+		return &introspection.TypeRef{Kind: introspection.TypeKindList, OfType: ref}
+	}
+	arg := func(name string, ref *introspection.TypeRef) introspection.InputValue {
+		return introspection.InputValue{Name: name, TypeRef: ref}
+	}
+	tcp := "TCP"
+
+	schema := &introspection.Schema{}
+	schema.QueryType.Name = "Query"
+	schema.Types = introspection.Types{
+		{Kind: introspection.TypeKindScalar, Name: "String"},
+		{Kind: introspection.TypeKindScalar, Name: "Int"},
+		{Kind: introspection.TypeKindScalar, Name: "Float"},
+		{Kind: introspection.TypeKindScalar, Name: "Boolean"},
+		{Kind: introspection.TypeKindScalar, Name: "ContainerID"},
+		{
+			Kind: introspection.TypeKindObject,
+			Name: "Query",
+			Fields: []*introspection.Field{
+				{Name: "container", TypeRef: nonNull(object("Container"))},
+				{
+					Name:    "loadContainerFromID",
+					TypeRef: nonNull(object("Container")),
+					Args: introspection.InputValues{
+						arg("id", nonNull(scalar("ContainerID"))),
+					},
+				},
+			},
+		},
+		{
+			Kind: introspection.TypeKindObject,
+			Name: "Container",
+			Fields: []*introspection.Field{
+				{
+					Name:    "from",
+					TypeRef: nonNull(object("Container")),
+					Args: introspection.InputValues{
+						arg("address", nonNull(scalar("String"))),
+					},
+				},
+				{
+					Name:    "withExec",
+					TypeRef: nonNull(object("Container")),
+					Args: introspection.InputValues{
+						arg("args", nonNull(list(nonNull(scalar("String"))))),
+						arg("skipEntrypoint", scalar("Boolean")),
+					},
+				},
+				{
+					Name:    "withEnvVariable",
+					TypeRef: nonNull(object("Container")),
+					Args: introspection.InputValues{
+						arg("name", nonNull(scalar("String"))),
+						arg("value", nonNull(scalar("String"))),
+						arg("expand", scalar("Boolean")),
+					},
+				},
+				{
+					Name:    "withExposedPort",
+					TypeRef: nonNull(object("Container")),
+					Args: introspection.InputValues{
+						arg("port", nonNull(scalar("Int"))),
+						{Name: "protocol", TypeRef: nonNull(enum("NetworkProtocol")), DefaultValue: &tcp},
+					},
+				},
+				{
+					Name:    "withCPUs",
+					TypeRef: nonNull(object("Container")),
+					Args: introspection.InputValues{
+						arg("cpus", nonNull(scalar("Float"))),
+					},
+				},
+				{
+					Name:    "build",
+					TypeRef: nonNull(object("Container")),
+					Args: introspection.InputValues{
+						arg("buildArgs", list(nonNull(input("BuildArg")))),
+					},
+				},
+				{Name: "id", TypeRef: nonNull(scalar("ContainerID"))},
+				{Name: "stdout", TypeRef: nonNull(scalar("String"))},
+				{Name: "workdir", TypeRef: scalar("String")},
+			},
+		},
+		{
+			Kind: introspection.TypeKindEnum,
+			Name: "NetworkProtocol",
+			EnumValues: []introspection.EnumValue{
+				{Name: "TCP"},
+				{Name: "UDP"},
+			},
+		},
+		{
+			Kind: introspection.TypeKindInputObject,
+			Name: "BuildArg",
+			InputFields: []introspection.InputValue{
+				arg("name", nonNull(scalar("String"))),
+				arg("value", nonNull(scalar("String"))),
+			},
+		},
+	}
+	return schema
+}
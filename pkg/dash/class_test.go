@@ -0,0 +1,105 @@
+package dash
+
+import "testing"
+
+func TestClassConstructor(t *testing.T) {
+	runCheckCases(t, []checkCase{
+		{
+			Name: "all required fields",
+			Src: `cls R {
+  pub url: String!
+  pub n: Int!
+  pub tag: String
+  pub ref: String! = "main"
+}
+pub r = R(url: "x", n: 1)`,
+		},
+		{
+			Name: "optional fields",
+			Src: `cls R {
+  pub url: String!
+  pub tag: String
+  pub ref: String! = "main"
+}
+pub r = R(url: "x", tag: "v1", ref: "dev")`,
+		},
+		{
+			Name: "missing required field",
+			Src: `cls R {
+  pub url: String!
+  pub n: Int!
+}
+pub r = R(url: "x")`,
+			Err: `missing required argument "n"`,
+		},
+		{
+			Name: "missing required fields",
+			Src: `cls R {
+  pub url: String!
+  pub n: Int!
+}
+pub r = R()`,
+			Err: `missing required arguments "url" and "n"`,
+		},
+		{
+			Name: "first unknown field",
+			Src: `cls R {
+  pub url: String!
+}
+pub r = R(url: "x", bogus: 1, zz: 2)`,
+			Err: `"bogus" not found in R`,
+		},
+		{
+			Name: "second unknown field",
+			Src: `cls R {
+  pub url: String!
+}
+pub r = R(url: "x", bogus: 1, zz: 2)`,
+			Err: `"zz" not found in R`,
+		},
+		{
+			Name: "mismatched field alongside missing field",
+			Src: `cls R {
+  pub url: String!
+  pub n: Int!
+}
+pub r = R(url: 1)`,
+			Err: `missing required argument "n"`,
+		},
+	})
+}
+
+func TestClassSelf(t *testing.T) {
+	runCheckCases(t, []checkCase{
+		{
+			Name: "select from self",
+			Src: `cls Foo {
+  pub bar: Int! = 1
+  pub baz: Int! { self.bar }
+}
+pub x: Int! = Foo().baz`,
+		},
+		{
+			Name: "select from the class",
+			Src: `cls Foo {
+  pub bar: Int! = 1
+  pub baz: Int! { Foo.bar }
+}`,
+		},
+		{
+			Name: "call self",
+			Src: `cls Foo {
+  pub bar: Int! = 1
+  pub with-bar(bar: Int!): Foo! { self(bar: bar) }
+}
+pub x: Int! = Foo().with-bar(2).bar`,
+		},
+		{
+			Name: "unknown field of self",
+			Src: `cls Foo {
+  pub baz: Int! { self.bogus }
+}`,
+			Err: `field "bogus" not found in record Foo`,
+		},
+	})
+}
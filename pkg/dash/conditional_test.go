@@ -0,0 +1,85 @@
+package dash
+
+import "testing"
+
+func TestConditionals(t *testing.T) {
+	runCheckCases(t, []checkCase{
+		{
+			Name: "same branch types",
+			Src:  `pub x: Int! = if true { 1 } else { 2 }`,
+		},
+		{
+			Name: "mismatched branch types",
+			Src:  `pub x = if true { 1 } else { "two" }`,
+			Err:  "branches have mismatched types",
+		},
+		{
+			Name: "null branch is nullable",
+			Src:  `pub x: Int = if true { null } else { 1 }`,
+		},
+		{
+			Name: "null branch where non-null is expected",
+			Src:  `pub x: Int! = if true { 1 } else { null }`,
+			Err:  "Int is nullable, but Int! is not",
+		},
+		{
+			Name: "non-boolean condition",
+			Src:  `pub x = if 1 { 1 } else { 2 }`,
+			Err:  "Boolean",
+		},
+		{
+			Name: "missing else is nullable",
+			Src:  `pub x: Int = if true { 1 }`,
+		},
+		{
+			Name: "missing else where non-null is expected",
+			Src:  `pub x: Int! = if true { 1 }`,
+			Err:  "Int is nullable, but Int! is not",
+		},
+		{
+			Name: "else if",
+			Src:  `pub x: String! = if false { "a" } else if true { "b" } else { "c" }`,
+		},
+		{
+			Name: "else if without else",
+			Src:  `pub x: String = if false { "a" } else if true { "b" }`,
+		},
+		{
+			Name: "nullable condition",
+			Src: `pub maybe: Boolean = null
+pub x = if maybe { 1 } else { 2 }`,
+			Err: "condition must be Boolean!",
+		},
+		{
+			Name: "comparison condition",
+			Src:  `pub x: String! = if 1 < 2 { "yes" } else { "no" }`,
+		},
+		{
+			Name: "object branches",
+			Src: `pub static = true
+pub c: Container! = if static { container.from("alpine") } else { container.from("debian") }`,
+		},
+		{
+			Name: "type variable branches",
+			Src: `pub f = fn(a, b) -> if true { a } else { b }
+pub x: Int! = f(1, 2)`,
+		},
+		{
+			Name: "type variable branches with mismatched arguments",
+			Src: `pub f = fn(a, b) -> if true { a } else { b }
+pub x: Int! = f(1, "s")`,
+			Err: "cannot unify",
+		},
+		{
+			Name: "type variable and concrete branches",
+			Src: `pub f = fn(a) -> if true { a } else { "s" }
+pub x: String! = f("t")`,
+		},
+		{
+			Name: "type variable and concrete branches with mismatched argument",
+			Src: `pub f = fn(a) -> if true { a } else { "s" }
+pub x = f(1)`,
+			Err: "cannot unify",
+		},
+	})
+}
@@ -22,45 +22,60 @@ var g = &grammar{
 	rules: []*rule{
 		{
 			name: "Dash",
-			pos:  position{line: 5, col: 1, offset: 19},
+			pos:  position{line: 23, col: 1, offset: 712},
 			expr: &actionExpr{
-				pos: position{line: 5, col: 9, offset: 27},
+				pos: position{line: 23, col: 9, offset: 720},
 				run: (*parser).callonDash1,
 				expr: &seqExpr{
-					pos: position{line: 5, col: 9, offset: 27},
+					pos: position{line: 23, col: 9, offset: 720},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 5, col: 9, offset: 27},
+							pos:   position{line: 23, col: 9, offset: 720},
 							label: "es",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 5, col: 12, offset: 30},
+								pos: position{line: 23, col: 12, offset: 723},
 								expr: &actionExpr{
-									pos: position{line: 5, col: 13, offset: 31},
+									pos: position{line: 23, col: 13, offset: 724},
 									run: (*parser).callonDash5,
 									expr: &seqExpr{
-										pos: position{line: 5, col: 13, offset: 31},
+										pos: position{line: 23, col: 13, offset: 724},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 5, col: 13, offset: 31},
+												pos:  position{line: 23, col: 13, offset: 724},
 												name: "_",
 											},
 											&labeledExpr{
-												pos:   position{line: 5, col: 15, offset: 33},
+												pos:   position{line: 23, col: 15, offset: 726},
 												label: "e",
-												expr: &ruleRefExpr{
-													pos:  position{line: 5, col: 17, offset: 35},
-													name: "Expr",
-												},
-											},
-											&ruleRefExpr{
-												pos:  position{line: 5, col: 22, offset: 40},
-												name: "_",
-											},
-											&zeroOrOneExpr{
-												pos: position{line: 5, col: 24, offset: 42},
-												expr: &ruleRefExpr{
-													pos:  position{line: 5, col: 24, offset: 42},
-													name: "CommaToken",
+												expr: &choiceExpr{
+													pos: position{line: 23, col: 18, offset: 729},
+													alternatives: []any{
+														&actionExpr{
+															pos: position{line: 23, col: 18, offset: 729},
+															run: (*parser).callonDash10,
+															expr: &seqExpr{
+																pos: position{line: 23, col: 18, offset: 729},
+																exprs: []any{
+																	&labeledExpr{
+																		pos:   position{line: 23, col: 18, offset: 729},
+																		label: "x",
+																		expr: &ruleRefExpr{
+																			pos:  position{line: 23, col: 20, offset: 731},
+																			name: "Expr",
+																		},
+																	},
+																	&ruleRefExpr{
+																		pos:  position{line: 23, col: 25, offset: 736},
+																		name: "Terminator",
+																	},
+																},
+															},
+														},
+														&ruleRefExpr{
+															pos:  position{line: 23, col: 56, offset: 767},
+															name: "Recover",
+														},
+													},
 												},
 											},
 										},
@@ -68,10 +83,14 @@ var g = &grammar{
 								},
 							},
 						},
+						&ruleRefExpr{
+							pos:  position{line: 23, col: 85, offset: 796},
+							name: "_",
+						},
 						&notExpr{
-							pos: position{line: 5, col: 56, offset: 74},
+							pos: position{line: 23, col: 87, offset: 798},
 							expr: &anyMatcher{
-								line: 5, col: 57, offset: 75,
+								line: 23, col: 88, offset: 799,
 							},
 						},
 					},
@@ -82,20 +101,24 @@ var g = &grammar{
 		},
 		{
 			name: "Expr",
-			pos:  position{line: 11, col: 1, offset: 171},
+			pos:  position{line: 29, col: 1, offset: 904},
 			expr: &choiceExpr{
-				pos: position{line: 11, col: 9, offset: 179},
+				pos: position{line: 29, col: 9, offset: 912},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 11, col: 9, offset: 179},
+						pos:  position{line: 29, col: 9, offset: 912},
 						name: "Class",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 11, col: 17, offset: 187},
+						pos:  position{line: 29, col: 17, offset: 920},
 						name: "Slot",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 11, col: 24, offset: 194},
+						pos:  position{line: 29, col: 24, offset: 927},
+						name: "Import",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 29, col: 33, offset: 936},
 						name: "Form",
 					},
 				},
@@ -104,48 +127,158 @@ var g = &grammar{
 			leftRecursive: false,
 		},
 		{
-			name: "Form",
-			pos:  position{line: 13, col: 1, offset: 200},
-			expr: &choiceExpr{
-				pos: position{line: 13, col: 9, offset: 208},
-				alternatives: []any{
-					&ruleRefExpr{
-						pos:  position{line: 13, col: 9, offset: 208},
-						name: "Infix",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 13, col: 17, offset: 216},
-						name: "Term",
+			name: "Import",
+			pos:  position{line: 31, col: 1, offset: 942},
+			expr: &actionExpr{
+				pos: position{line: 31, col: 11, offset: 952},
+				run: (*parser).callonImport1,
+				expr: &seqExpr{
+					pos: position{line: 31, col: 11, offset: 952},
+					exprs: []any{
+						&ruleRefExpr{
+							pos:  position{line: 31, col: 11, offset: 952},
+							name: "ImportToken",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 31, col: 23, offset: 964},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 31, col: 25, offset: 966},
+							label: "path",
+							expr: &ruleRefExpr{
+								pos:  position{line: 31, col: 30, offset: 971},
+								name: "String",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 31, col: 37, offset: 978},
+							label: "alias",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 31, col: 43, offset: 984},
+								expr: &actionExpr{
+									pos: position{line: 31, col: 44, offset: 985},
+									run: (*parser).callonImport9,
+									expr: &seqExpr{
+										pos: position{line: 31, col: 44, offset: 985},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 31, col: 44, offset: 985},
+												name: "_",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 31, col: 46, offset: 987},
+												name: "AsToken",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 31, col: 54, offset: 995},
+												name: "_",
+											},
+											&labeledExpr{
+												pos:   position{line: 31, col: 56, offset: 997},
+												label: "name",
+												expr: &ruleRefExpr{
+													pos:  position{line: 31, col: 61, offset: 1002},
+													name: "Id",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
 					},
 				},
 			},
 			leader:        false,
-			leftRecursive: true,
+			leftRecursive: false,
+		},
+		{
+			name: "ImportToken",
+			pos:  position{line: 46, col: 1, offset: 1316},
+			expr: &litMatcher{
+				pos:        position{line: 46, col: 16, offset: 1331},
+				val:        "import",
+				ignoreCase: false,
+				want:       "\"import\"",
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "AsToken",
+			pos:  position{line: 47, col: 1, offset: 1340},
+			expr: &litMatcher{
+				pos:        position{line: 47, col: 12, offset: 1351},
+				val:        "as",
+				ignoreCase: false,
+				want:       "\"as\"",
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "Form",
+			pos:  position{line: 49, col: 1, offset: 1357},
+			expr: &ruleRefExpr{
+				pos:  position{line: 49, col: 9, offset: 1365},
+				name: "Infix",
+			},
+			leader:        false,
+			leftRecursive: false,
 		},
 		{
 			name: "Term",
-			pos:  position{line: 15, col: 1, offset: 222},
+			pos:  position{line: 51, col: 1, offset: 1372},
 			expr: &choiceExpr{
-				pos: position{line: 15, col: 9, offset: 230},
+				pos: position{line: 51, col: 9, offset: 1380},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 15, col: 9, offset: 230},
+						pos:  position{line: 51, col: 9, offset: 1380},
 						name: "Select",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 15, col: 18, offset: 239},
+						pos:  position{line: 51, col: 18, offset: 1389},
 						name: "FunCall",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 15, col: 28, offset: 249},
+						pos:  position{line: 51, col: 28, offset: 1399},
+						name: "Exec",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 51, col: 35, offset: 1406},
+						name: "Join",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 51, col: 42, offset: 1413},
+						name: "Parens",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 51, col: 51, offset: 1422},
 						name: "List",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 15, col: 35, offset: 256},
+						pos:  position{line: 51, col: 58, offset: 1429},
+						name: "Record",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 51, col: 67, offset: 1438},
+						name: "Lambda",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 51, col: 76, offset: 1447},
+						name: "Conditional",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 51, col: 90, offset: 1461},
+						name: "Case",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 51, col: 97, offset: 1468},
 						name: "Literal",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 15, col: 45, offset: 266},
+						pos:  position{line: 51, col: 107, offset: 1478},
 						name: "Symbol",
 					},
 				},
@@ -155,38 +288,38 @@ var g = &grammar{
 		},
 		{
 			name: "Class",
-			pos:  position{line: 17, col: 1, offset: 274},
+			pos:  position{line: 53, col: 1, offset: 1486},
 			expr: &actionExpr{
-				pos: position{line: 17, col: 10, offset: 283},
+				pos: position{line: 53, col: 10, offset: 1495},
 				run: (*parser).callonClass1,
 				expr: &seqExpr{
-					pos: position{line: 17, col: 10, offset: 283},
+					pos: position{line: 53, col: 10, offset: 1495},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 17, col: 10, offset: 283},
+							pos:  position{line: 53, col: 10, offset: 1495},
 							name: "ClsToken",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 17, col: 19, offset: 292},
+							pos:  position{line: 53, col: 19, offset: 1504},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 17, col: 21, offset: 294},
+							pos:   position{line: 53, col: 21, offset: 1506},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 17, col: 26, offset: 299},
+								pos:  position{line: 53, col: 26, offset: 1511},
 								name: "Id",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 17, col: 29, offset: 302},
+							pos:  position{line: 53, col: 29, offset: 1514},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 17, col: 31, offset: 304},
+							pos:   position{line: 53, col: 31, offset: 1516},
 							label: "block",
 							expr: &ruleRefExpr{
-								pos:  position{line: 17, col: 37, offset: 310},
+								pos:  position{line: 53, col: 37, offset: 1522},
 								name: "Block",
 							},
 						},
@@ -198,9 +331,9 @@ var g = &grammar{
 		},
 		{
 			name: "ClsToken",
-			pos:  position{line: 24, col: 1, offset: 444},
+			pos:  position{line: 62, col: 1, offset: 1707},
 			expr: &litMatcher{
-				pos:        position{line: 24, col: 13, offset: 456},
+				pos:        position{line: 62, col: 13, offset: 1719},
 				val:        "cls",
 				ignoreCase: false,
 				want:       "\"cls\"",
@@ -210,28 +343,28 @@ var g = &grammar{
 		},
 		{
 			name: "Slot",
-			pos:  position{line: 26, col: 1, offset: 463},
+			pos:  position{line: 64, col: 1, offset: 1726},
 			expr: &choiceExpr{
-				pos: position{line: 26, col: 9, offset: 471},
+				pos: position{line: 64, col: 9, offset: 1734},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 26, col: 9, offset: 471},
+						pos:  position{line: 64, col: 9, offset: 1734},
 						name: "TypeAndArgsAndBlockSlot",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 27, col: 9, offset: 540},
+						pos:  position{line: 65, col: 9, offset: 1803},
 						name: "TypeAndBlockSlot",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 28, col: 9, offset: 674},
+						pos:  position{line: 66, col: 9, offset: 1937},
 						name: "TypeAndValueSlot",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 29, col: 9, offset: 813},
+						pos:  position{line: 67, col: 9, offset: 2076},
 						name: "ValueOnlySlot",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 30, col: 9, offset: 907},
+						pos:  position{line: 68, col: 9, offset: 2170},
 						name: "TypeOnlySlot",
 					},
 				},
@@ -241,72 +374,72 @@ var g = &grammar{
 		},
 		{
 			name: "TypeAndValueSlot",
-			pos:  position{line: 32, col: 1, offset: 995},
+			pos:  position{line: 70, col: 1, offset: 2258},
 			expr: &actionExpr{
-				pos: position{line: 32, col: 21, offset: 1015},
+				pos: position{line: 70, col: 21, offset: 2278},
 				run: (*parser).callonTypeAndValueSlot1,
 				expr: &seqExpr{
-					pos: position{line: 32, col: 21, offset: 1015},
+					pos: position{line: 70, col: 21, offset: 2278},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 32, col: 21, offset: 1015},
+							pos:   position{line: 70, col: 21, offset: 2278},
 							label: "vis",
 							expr: &ruleRefExpr{
-								pos:  position{line: 32, col: 25, offset: 1019},
+								pos:  position{line: 70, col: 25, offset: 2282},
 								name: "Visibility",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 32, col: 36, offset: 1030},
+							pos:  position{line: 70, col: 36, offset: 2293},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 32, col: 38, offset: 1032},
+							pos:   position{line: 70, col: 38, offset: 2295},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 32, col: 43, offset: 1037},
+								pos:  position{line: 70, col: 43, offset: 2300},
 								name: "Id",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 32, col: 46, offset: 1040},
+							pos:  position{line: 70, col: 46, offset: 2303},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 32, col: 48, offset: 1042},
+							pos:  position{line: 70, col: 48, offset: 2305},
 							name: "ColonToken",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 32, col: 59, offset: 1053},
+							pos:  position{line: 70, col: 59, offset: 2316},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 32, col: 61, offset: 1055},
+							pos:   position{line: 70, col: 61, offset: 2318},
 							label: "type_",
 							expr: &ruleRefExpr{
-								pos:  position{line: 32, col: 67, offset: 1061},
+								pos:  position{line: 70, col: 67, offset: 2324},
 								name: "Type",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 32, col: 72, offset: 1066},
+							pos:  position{line: 70, col: 72, offset: 2329},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 32, col: 74, offset: 1068},
+							pos:        position{line: 70, col: 74, offset: 2331},
 							val:        "=",
 							ignoreCase: false,
 							want:       "\"=\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 32, col: 78, offset: 1072},
+							pos:  position{line: 70, col: 78, offset: 2335},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 32, col: 80, offset: 1074},
+							pos:   position{line: 70, col: 80, offset: 2337},
 							label: "value",
 							expr: &ruleRefExpr{
-								pos:  position{line: 32, col: 86, offset: 1080},
+								pos:  position{line: 70, col: 86, offset: 2343},
 								name: "Form",
 							},
 						},
@@ -318,52 +451,52 @@ var g = &grammar{
 		},
 		{
 			name: "ValueOnlySlot",
-			pos:  position{line: 41, col: 1, offset: 1232},
+			pos:  position{line: 81, col: 1, offset: 2546},
 			expr: &actionExpr{
-				pos: position{line: 41, col: 18, offset: 1249},
+				pos: position{line: 81, col: 18, offset: 2563},
 				run: (*parser).callonValueOnlySlot1,
 				expr: &seqExpr{
-					pos: position{line: 41, col: 18, offset: 1249},
+					pos: position{line: 81, col: 18, offset: 2563},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 41, col: 18, offset: 1249},
+							pos:   position{line: 81, col: 18, offset: 2563},
 							label: "vis",
 							expr: &ruleRefExpr{
-								pos:  position{line: 41, col: 22, offset: 1253},
+								pos:  position{line: 81, col: 22, offset: 2567},
 								name: "Visibility",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 41, col: 33, offset: 1264},
+							pos:  position{line: 81, col: 33, offset: 2578},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 41, col: 35, offset: 1266},
+							pos:   position{line: 81, col: 35, offset: 2580},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 41, col: 40, offset: 1271},
+								pos:  position{line: 81, col: 40, offset: 2585},
 								name: "Id",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 41, col: 43, offset: 1274},
+							pos:  position{line: 81, col: 43, offset: 2588},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 41, col: 45, offset: 1276},
+							pos:        position{line: 81, col: 45, offset: 2590},
 							val:        "=",
 							ignoreCase: false,
 							want:       "\"=\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 41, col: 49, offset: 1280},
+							pos:  position{line: 81, col: 49, offset: 2594},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 41, col: 51, offset: 1282},
+							pos:   position{line: 81, col: 51, offset: 2596},
 							label: "val",
 							expr: &ruleRefExpr{
-								pos:  position{line: 41, col: 55, offset: 1286},
+								pos:  position{line: 81, col: 55, offset: 2600},
 								name: "Form",
 							},
 						},
@@ -375,50 +508,50 @@ var g = &grammar{
 		},
 		{
 			name: "TypeOnlySlot",
-			pos:  position{line: 49, col: 1, offset: 1407},
+			pos:  position{line: 91, col: 1, offset: 2772},
 			expr: &actionExpr{
-				pos: position{line: 49, col: 17, offset: 1423},
+				pos: position{line: 91, col: 17, offset: 2788},
 				run: (*parser).callonTypeOnlySlot1,
 				expr: &seqExpr{
-					pos: position{line: 49, col: 17, offset: 1423},
+					pos: position{line: 91, col: 17, offset: 2788},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 49, col: 17, offset: 1423},
+							pos:   position{line: 91, col: 17, offset: 2788},
 							label: "vis",
 							expr: &ruleRefExpr{
-								pos:  position{line: 49, col: 21, offset: 1427},
+								pos:  position{line: 91, col: 21, offset: 2792},
 								name: "Visibility",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 49, col: 32, offset: 1438},
+							pos:  position{line: 91, col: 32, offset: 2803},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 49, col: 34, offset: 1440},
+							pos:   position{line: 91, col: 34, offset: 2805},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 49, col: 39, offset: 1445},
+								pos:  position{line: 91, col: 39, offset: 2810},
 								name: "Id",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 49, col: 42, offset: 1448},
+							pos:  position{line: 91, col: 42, offset: 2813},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 49, col: 44, offset: 1450},
+							pos:  position{line: 91, col: 44, offset: 2815},
 							name: "ColonToken",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 49, col: 55, offset: 1461},
+							pos:  position{line: 91, col: 55, offset: 2826},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 49, col: 57, offset: 1463},
+							pos:   position{line: 91, col: 57, offset: 2828},
 							label: "type_",
 							expr: &ruleRefExpr{
-								pos:  position{line: 49, col: 63, offset: 1469},
+								pos:  position{line: 91, col: 63, offset: 2834},
 								name: "Type",
 							},
 						},
@@ -430,62 +563,62 @@ var g = &grammar{
 		},
 		{
 			name: "TypeAndBlockSlot",
-			pos:  position{line: 57, col: 1, offset: 1596},
+			pos:  position{line: 101, col: 1, offset: 3012},
 			expr: &actionExpr{
-				pos: position{line: 57, col: 21, offset: 1616},
+				pos: position{line: 101, col: 21, offset: 3032},
 				run: (*parser).callonTypeAndBlockSlot1,
 				expr: &seqExpr{
-					pos: position{line: 57, col: 21, offset: 1616},
+					pos: position{line: 101, col: 21, offset: 3032},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 57, col: 21, offset: 1616},
+							pos:   position{line: 101, col: 21, offset: 3032},
 							label: "vis",
 							expr: &ruleRefExpr{
-								pos:  position{line: 57, col: 25, offset: 1620},
+								pos:  position{line: 101, col: 25, offset: 3036},
 								name: "Visibility",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 57, col: 36, offset: 1631},
+							pos:  position{line: 101, col: 36, offset: 3047},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 57, col: 38, offset: 1633},
+							pos:   position{line: 101, col: 38, offset: 3049},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 57, col: 43, offset: 1638},
+								pos:  position{line: 101, col: 43, offset: 3054},
 								name: "Id",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 57, col: 46, offset: 1641},
+							pos:  position{line: 101, col: 46, offset: 3057},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 57, col: 48, offset: 1643},
+							pos:  position{line: 101, col: 48, offset: 3059},
 							name: "ColonToken",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 57, col: 59, offset: 1654},
+							pos:  position{line: 101, col: 59, offset: 3070},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 57, col: 61, offset: 1656},
+							pos:   position{line: 101, col: 61, offset: 3072},
 							label: "type_",
 							expr: &ruleRefExpr{
-								pos:  position{line: 57, col: 67, offset: 1662},
+								pos:  position{line: 101, col: 67, offset: 3078},
 								name: "Type",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 57, col: 72, offset: 1667},
-							name: "_",
+							pos:  position{line: 101, col: 72, offset: 3083},
+							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 57, col: 74, offset: 1669},
+							pos:   position{line: 101, col: 75, offset: 3086},
 							label: "block",
 							expr: &ruleRefExpr{
-								pos:  position{line: 57, col: 80, offset: 1675},
+								pos:  position{line: 101, col: 81, offset: 3092},
 								name: "Block",
 							},
 						},
@@ -497,74 +630,74 @@ var g = &grammar{
 		},
 		{
 			name: "TypeAndArgsAndBlockSlot",
-			pos:  position{line: 70, col: 1, offset: 1932},
+			pos:  position{line: 118, col: 1, offset: 3464},
 			expr: &actionExpr{
-				pos: position{line: 70, col: 28, offset: 1959},
+				pos: position{line: 118, col: 28, offset: 3491},
 				run: (*parser).callonTypeAndArgsAndBlockSlot1,
 				expr: &seqExpr{
-					pos: position{line: 70, col: 28, offset: 1959},
+					pos: position{line: 118, col: 28, offset: 3491},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 70, col: 28, offset: 1959},
+							pos:   position{line: 118, col: 28, offset: 3491},
 							label: "vis",
 							expr: &ruleRefExpr{
-								pos:  position{line: 70, col: 32, offset: 1963},
+								pos:  position{line: 118, col: 32, offset: 3495},
 								name: "Visibility",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 70, col: 43, offset: 1974},
+							pos:  position{line: 118, col: 43, offset: 3506},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 70, col: 45, offset: 1976},
+							pos:   position{line: 118, col: 45, offset: 3508},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 70, col: 50, offset: 1981},
+								pos:  position{line: 118, col: 50, offset: 3513},
 								name: "Id",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 70, col: 53, offset: 1984},
+							pos:  position{line: 118, col: 53, offset: 3516},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 70, col: 55, offset: 1986},
+							pos:   position{line: 118, col: 55, offset: 3518},
 							label: "args",
 							expr: &ruleRefExpr{
-								pos:  position{line: 70, col: 60, offset: 1991},
+								pos:  position{line: 118, col: 60, offset: 3523},
 								name: "ArgTypes",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 70, col: 69, offset: 2000},
+							pos:  position{line: 118, col: 69, offset: 3532},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 70, col: 71, offset: 2002},
+							pos:  position{line: 118, col: 71, offset: 3534},
 							name: "ColonToken",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 70, col: 82, offset: 2013},
+							pos:  position{line: 118, col: 82, offset: 3545},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 70, col: 84, offset: 2015},
+							pos:   position{line: 118, col: 84, offset: 3547},
 							label: "type_",
 							expr: &ruleRefExpr{
-								pos:  position{line: 70, col: 90, offset: 2021},
+								pos:  position{line: 118, col: 90, offset: 3553},
 								name: "Type",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 70, col: 95, offset: 2026},
-							name: "_",
+							pos:  position{line: 118, col: 95, offset: 3558},
+							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 70, col: 97, offset: 2028},
+							pos:   position{line: 118, col: 98, offset: 3561},
 							label: "block",
 							expr: &ruleRefExpr{
-								pos:  position{line: 70, col: 103, offset: 2034},
+								pos:  position{line: 118, col: 104, offset: 3567},
 								name: "Block",
 							},
 						},
@@ -576,23 +709,23 @@ var g = &grammar{
 		},
 		{
 			name: "Visibility",
-			pos:  position{line: 84, col: 1, offset: 2336},
+			pos:  position{line: 136, col: 1, offset: 3984},
 			expr: &choiceExpr{
-				pos: position{line: 84, col: 15, offset: 2350},
+				pos: position{line: 136, col: 15, offset: 3998},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 84, col: 15, offset: 2350},
+						pos: position{line: 136, col: 15, offset: 3998},
 						run: (*parser).callonVisibility2,
 						expr: &ruleRefExpr{
-							pos:  position{line: 84, col: 15, offset: 2350},
+							pos:  position{line: 136, col: 15, offset: 3998},
 							name: "PubToken",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 85, col: 15, offset: 2406},
+						pos: position{line: 137, col: 15, offset: 4054},
 						run: (*parser).callonVisibility4,
 						expr: &ruleRefExpr{
-							pos:  position{line: 85, col: 15, offset: 2406},
+							pos:  position{line: 137, col: 15, offset: 4054},
 							name: "PvtToken",
 						},
 					},
@@ -603,9 +736,9 @@ var g = &grammar{
 		},
 		{
 			name: "PubToken",
-			pos:  position{line: 86, col: 1, offset: 2449},
+			pos:  position{line: 138, col: 1, offset: 4097},
 			expr: &litMatcher{
-				pos:        position{line: 86, col: 13, offset: 2461},
+				pos:        position{line: 138, col: 13, offset: 4109},
 				val:        "pub",
 				ignoreCase: false,
 				want:       "\"pub\"",
@@ -615,9 +748,9 @@ var g = &grammar{
 		},
 		{
 			name: "PvtToken",
-			pos:  position{line: 87, col: 1, offset: 2467},
+			pos:  position{line: 139, col: 1, offset: 4115},
 			expr: &litMatcher{
-				pos:        position{line: 87, col: 13, offset: 2479},
+				pos:        position{line: 139, col: 13, offset: 4127},
 				val:        "pvt",
 				ignoreCase: false,
 				want:       "\"pvt\"",
@@ -627,28 +760,76 @@ var g = &grammar{
 		},
 		{
 			name: "Id",
-			pos:  position{line: 89, col: 1, offset: 2486},
-			expr: &ruleRefExpr{
-				pos:  position{line: 89, col: 7, offset: 2492},
-				name: "WordToken",
+			pos:  position{line: 141, col: 1, offset: 4134},
+			expr: &actionExpr{
+				pos: position{line: 141, col: 7, offset: 4140},
+				run: (*parser).callonId1,
+				expr: &seqExpr{
+					pos: position{line: 141, col: 7, offset: 4140},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 141, col: 7, offset: 4140},
+							label: "name",
+							expr: &ruleRefExpr{
+								pos:  position{line: 141, col: 12, offset: 4145},
+								name: "WordToken",
+							},
+						},
+						&andCodeExpr{
+							pos: position{line: 141, col: 22, offset: 4155},
+							run: (*parser).callonId5,
+						},
+					},
+				},
 			},
 			leader:        false,
 			leftRecursive: false,
 		},
 		{
 			name: "WordToken",
-			pos:  position{line: 90, col: 1, offset: 2502},
+			pos:  position{line: 147, col: 1, offset: 4442},
 			expr: &actionExpr{
-				pos: position{line: 90, col: 14, offset: 2515},
+				pos: position{line: 147, col: 14, offset: 4455},
 				run: (*parser).callonWordToken1,
-				expr: &oneOrMoreExpr{
-					pos: position{line: 90, col: 14, offset: 2515},
-					expr: &charClassMatcher{
-						pos:        position{line: 90, col: 14, offset: 2515},
-						val:        "[a-zA-Z0-9]",
-						ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
-						ignoreCase: false,
-						inverted:   false,
+				expr: &seqExpr{
+					pos: position{line: 147, col: 14, offset: 4455},
+					exprs: []any{
+						&oneOrMoreExpr{
+							pos: position{line: 147, col: 14, offset: 4455},
+							expr: &charClassMatcher{
+								pos:        position{line: 147, col: 14, offset: 4455},
+								val:        "[a-zA-Z0-9_]",
+								chars:      []rune{'_'},
+								ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+								ignoreCase: false,
+								inverted:   false,
+							},
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 147, col: 28, offset: 4469},
+							expr: &seqExpr{
+								pos: position{line: 147, col: 29, offset: 4470},
+								exprs: []any{
+									&litMatcher{
+										pos:        position{line: 147, col: 29, offset: 4470},
+										val:        "-",
+										ignoreCase: false,
+										want:       "\"-\"",
+									},
+									&oneOrMoreExpr{
+										pos: position{line: 147, col: 33, offset: 4474},
+										expr: &charClassMatcher{
+											pos:        position{line: 147, col: 33, offset: 4474},
+											val:        "[a-zA-Z0-9_]",
+											chars:      []rune{'_'},
+											ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+											ignoreCase: false,
+											inverted:   false,
+										},
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -657,9 +838,9 @@ var g = &grammar{
 		},
 		{
 			name: "UpperId",
-			pos:  position{line: 93, col: 1, offset: 2561},
+			pos:  position{line: 150, col: 1, offset: 4523},
 			expr: &ruleRefExpr{
-				pos:  position{line: 93, col: 12, offset: 2572},
+				pos:  position{line: 150, col: 12, offset: 4534},
 				name: "UpperToken",
 			},
 			leader:        false,
@@ -667,24 +848,24 @@ var g = &grammar{
 		},
 		{
 			name: "UpperToken",
-			pos:  position{line: 94, col: 1, offset: 2583},
+			pos:  position{line: 151, col: 1, offset: 4545},
 			expr: &actionExpr{
-				pos: position{line: 94, col: 15, offset: 2597},
+				pos: position{line: 151, col: 15, offset: 4559},
 				run: (*parser).callonUpperToken1,
 				expr: &seqExpr{
-					pos: position{line: 94, col: 15, offset: 2597},
+					pos: position{line: 151, col: 15, offset: 4559},
 					exprs: []any{
 						&charClassMatcher{
-							pos:        position{line: 94, col: 15, offset: 2597},
+							pos:        position{line: 151, col: 15, offset: 4559},
 							val:        "[A-Z]",
 							ranges:     []rune{'A', 'Z'},
 							ignoreCase: false,
 							inverted:   false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 94, col: 20, offset: 2602},
+							pos: position{line: 151, col: 20, offset: 4564},
 							expr: &charClassMatcher{
-								pos:        position{line: 94, col: 20, offset: 2602},
+								pos:        position{line: 151, col: 20, offset: 4564},
 								val:        "[a-zA-Z0-9]",
 								ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
 								ignoreCase: false,
@@ -699,26 +880,26 @@ var g = &grammar{
 		},
 		{
 			name: "FunCall",
-			pos:  position{line: 98, col: 1, offset: 2649},
+			pos:  position{line: 155, col: 1, offset: 4611},
 			expr: &actionExpr{
-				pos: position{line: 98, col: 12, offset: 2660},
+				pos: position{line: 155, col: 12, offset: 4622},
 				run: (*parser).callonFunCall1,
 				expr: &seqExpr{
-					pos: position{line: 98, col: 12, offset: 2660},
+					pos: position{line: 155, col: 12, offset: 4622},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 98, col: 12, offset: 2660},
+							pos:   position{line: 155, col: 12, offset: 4622},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 98, col: 17, offset: 2665},
+								pos:  position{line: 155, col: 17, offset: 4627},
 								name: "Term",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 98, col: 22, offset: 2670},
+							pos:   position{line: 155, col: 22, offset: 4632},
 							label: "args",
 							expr: &ruleRefExpr{
-								pos:  position{line: 98, col: 27, offset: 2675},
+								pos:  position{line: 155, col: 27, offset: 4637},
 								name: "ArgValues",
 							},
 						},
@@ -730,32 +911,49 @@ var g = &grammar{
 		},
 		{
 			name: "ArgValues",
-			pos:  position{line: 102, col: 1, offset: 2755},
+			pos:  position{line: 161, col: 1, offset: 4859},
 			expr: &actionExpr{
-				pos: position{line: 102, col: 14, offset: 2768},
+				pos: position{line: 161, col: 14, offset: 4872},
 				run: (*parser).callonArgValues1,
 				expr: &seqExpr{
-					pos: position{line: 102, col: 14, offset: 2768},
+					pos: position{line: 161, col: 14, offset: 4872},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 102, col: 14, offset: 2768},
+							pos:        position{line: 161, col: 14, offset: 4872},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
+						&ruleRefExpr{
+							pos:  position{line: 161, col: 18, offset: 4876},
+							name: "_",
+						},
 						&labeledExpr{
-							pos:   position{line: 102, col: 18, offset: 2772},
+							pos:   position{line: 161, col: 20, offset: 4878},
 							label: "args",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 102, col: 23, offset: 2777},
-								expr: &ruleRefExpr{
-									pos:  position{line: 102, col: 23, offset: 2777},
-									name: "KeyValue",
+								pos: position{line: 161, col: 25, offset: 4883},
+								expr: &choiceExpr{
+									pos: position{line: 161, col: 26, offset: 4884},
+									alternatives: []any{
+										&ruleRefExpr{
+											pos:  position{line: 161, col: 26, offset: 4884},
+											name: "KeyValue",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 161, col: 37, offset: 4895},
+											name: "PositionalValue",
+										},
+									},
 								},
 							},
 						},
+						&ruleRefExpr{
+							pos:  position{line: 161, col: 55, offset: 4913},
+							name: "_",
+						},
 						&litMatcher{
-							pos:        position{line: 102, col: 33, offset: 2787},
+							pos:        position{line: 161, col: 57, offset: 4915},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -766,34 +964,72 @@ var g = &grammar{
 			leader:        false,
 			leftRecursive: false,
 		},
+		{
+			name: "PositionalValue",
+			pos:  position{line: 164, col: 1, offset: 4964},
+			expr: &actionExpr{
+				pos: position{line: 164, col: 20, offset: 4983},
+				run: (*parser).callonPositionalValue1,
+				expr: &seqExpr{
+					pos: position{line: 164, col: 20, offset: 4983},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 164, col: 20, offset: 4983},
+							label: "value",
+							expr: &ruleRefExpr{
+								pos:  position{line: 164, col: 26, offset: 4989},
+								name: "Form",
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 164, col: 31, offset: 4994},
+							expr: &ruleRefExpr{
+								pos:  position{line: 164, col: 31, offset: 4994},
+								name: "CommaToken",
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
 		{
 			name: "ArgTypes",
-			pos:  position{line: 105, col: 1, offset: 2836},
+			pos:  position{line: 167, col: 1, offset: 5054},
 			expr: &actionExpr{
-				pos: position{line: 105, col: 13, offset: 2848},
+				pos: position{line: 167, col: 13, offset: 5066},
 				run: (*parser).callonArgTypes1,
 				expr: &seqExpr{
-					pos: position{line: 105, col: 13, offset: 2848},
+					pos: position{line: 167, col: 13, offset: 5066},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 105, col: 13, offset: 2848},
+							pos:        position{line: 167, col: 13, offset: 5066},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
+						&ruleRefExpr{
+							pos:  position{line: 167, col: 17, offset: 5070},
+							name: "_",
+						},
 						&labeledExpr{
-							pos:   position{line: 105, col: 17, offset: 2852},
+							pos:   position{line: 167, col: 19, offset: 5072},
 							label: "args",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 105, col: 22, offset: 2857},
+								pos: position{line: 167, col: 24, offset: 5077},
 								expr: &ruleRefExpr{
-									pos:  position{line: 105, col: 22, offset: 2857},
+									pos:  position{line: 167, col: 24, offset: 5077},
 									name: "ArgType",
 								},
 							},
 						},
+						&ruleRefExpr{
+							pos:  position{line: 167, col: 33, offset: 5086},
+							name: "_",
+						},
 						&litMatcher{
-							pos:        position{line: 105, col: 31, offset: 2866},
+							pos:        position{line: 167, col: 35, offset: 5088},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -806,38 +1042,38 @@ var g = &grammar{
 		},
 		{
 			name: "ArgType",
-			pos:  position{line: 108, col: 1, offset: 2912},
+			pos:  position{line: 170, col: 1, offset: 5134},
 			expr: &actionExpr{
-				pos: position{line: 108, col: 12, offset: 2923},
+				pos: position{line: 170, col: 12, offset: 5145},
 				run: (*parser).callonArgType1,
 				expr: &seqExpr{
-					pos: position{line: 108, col: 12, offset: 2923},
+					pos: position{line: 170, col: 12, offset: 5145},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 108, col: 12, offset: 2923},
+							pos:   position{line: 170, col: 12, offset: 5145},
 							label: "slot",
 							expr: &choiceExpr{
-								pos: position{line: 108, col: 18, offset: 2929},
+								pos: position{line: 170, col: 18, offset: 5151},
 								alternatives: []any{
 									&ruleRefExpr{
-										pos:  position{line: 108, col: 18, offset: 2929},
+										pos:  position{line: 170, col: 18, offset: 5151},
 										name: "ArgWithDefault",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 108, col: 35, offset: 2946},
+										pos:  position{line: 170, col: 35, offset: 5168},
 										name: "ArgWithBlockDefault",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 108, col: 57, offset: 2968},
+										pos:  position{line: 170, col: 57, offset: 5190},
 										name: "ArgWithType",
 									},
 								},
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 108, col: 70, offset: 2981},
+							pos: position{line: 170, col: 70, offset: 5203},
 							expr: &ruleRefExpr{
-								pos:  position{line: 108, col: 70, offset: 2981},
+								pos:  position{line: 170, col: 70, offset: 5203},
 								name: "CommaToken",
 							},
 						},
@@ -849,60 +1085,60 @@ var g = &grammar{
 		},
 		{
 			name: "ArgWithDefault",
-			pos:  position{line: 111, col: 1, offset: 3027},
+			pos:  position{line: 173, col: 1, offset: 5249},
 			expr: &actionExpr{
-				pos: position{line: 111, col: 19, offset: 3045},
+				pos: position{line: 173, col: 19, offset: 5267},
 				run: (*parser).callonArgWithDefault1,
 				expr: &seqExpr{
-					pos: position{line: 111, col: 19, offset: 3045},
+					pos: position{line: 173, col: 19, offset: 5267},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 111, col: 19, offset: 3045},
+							pos:   position{line: 173, col: 19, offset: 5267},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 111, col: 24, offset: 3050},
+								pos:  position{line: 173, col: 24, offset: 5272},
 								name: "Id",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 111, col: 27, offset: 3053},
+							pos:  position{line: 173, col: 27, offset: 5275},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 111, col: 29, offset: 3055},
+							pos:  position{line: 173, col: 29, offset: 5277},
 							name: "ColonToken",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 111, col: 40, offset: 3066},
+							pos:  position{line: 173, col: 40, offset: 5288},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 111, col: 42, offset: 3068},
+							pos:   position{line: 173, col: 42, offset: 5290},
 							label: "type_",
 							expr: &ruleRefExpr{
-								pos:  position{line: 111, col: 48, offset: 3074},
+								pos:  position{line: 173, col: 48, offset: 5296},
 								name: "Type",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 111, col: 53, offset: 3079},
+							pos:  position{line: 173, col: 53, offset: 5301},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 111, col: 55, offset: 3081},
+							pos:        position{line: 173, col: 55, offset: 5303},
 							val:        "=",
 							ignoreCase: false,
 							want:       "\"=\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 111, col: 59, offset: 3085},
+							pos:  position{line: 173, col: 59, offset: 5307},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 111, col: 61, offset: 3087},
+							pos:   position{line: 173, col: 61, offset: 5309},
 							label: "value",
 							expr: &ruleRefExpr{
-								pos:  position{line: 111, col: 67, offset: 3093},
+								pos:  position{line: 173, col: 67, offset: 5315},
 								name: "Form",
 							},
 						},
@@ -914,50 +1150,50 @@ var g = &grammar{
 		},
 		{
 			name: "ArgWithBlockDefault",
-			pos:  position{line: 118, col: 1, offset: 3210},
+			pos:  position{line: 182, col: 1, offset: 5483},
 			expr: &actionExpr{
-				pos: position{line: 118, col: 24, offset: 3233},
+				pos: position{line: 182, col: 24, offset: 5506},
 				run: (*parser).callonArgWithBlockDefault1,
 				expr: &seqExpr{
-					pos: position{line: 118, col: 24, offset: 3233},
+					pos: position{line: 182, col: 24, offset: 5506},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 118, col: 24, offset: 3233},
+							pos:   position{line: 182, col: 24, offset: 5506},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 118, col: 29, offset: 3238},
+								pos:  position{line: 182, col: 29, offset: 5511},
 								name: "Id",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 118, col: 32, offset: 3241},
+							pos:  position{line: 182, col: 32, offset: 5514},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 118, col: 34, offset: 3243},
+							pos:  position{line: 182, col: 34, offset: 5516},
 							name: "ColonToken",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 118, col: 45, offset: 3254},
+							pos:  position{line: 182, col: 45, offset: 5527},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 118, col: 47, offset: 3256},
+							pos:   position{line: 182, col: 47, offset: 5529},
 							label: "type_",
 							expr: &ruleRefExpr{
-								pos:  position{line: 118, col: 53, offset: 3262},
+								pos:  position{line: 182, col: 53, offset: 5535},
 								name: "Type",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 118, col: 58, offset: 3267},
+							pos:  position{line: 182, col: 58, offset: 5540},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 118, col: 60, offset: 3269},
+							pos:   position{line: 182, col: 60, offset: 5542},
 							label: "block",
 							expr: &ruleRefExpr{
-								pos:  position{line: 118, col: 66, offset: 3275},
+								pos:  position{line: 182, col: 66, offset: 5548},
 								name: "Block",
 							},
 						},
@@ -969,38 +1205,38 @@ var g = &grammar{
 		},
 		{
 			name: "ArgWithType",
-			pos:  position{line: 125, col: 1, offset: 3394},
+			pos:  position{line: 191, col: 1, offset: 5718},
 			expr: &actionExpr{
-				pos: position{line: 125, col: 16, offset: 3409},
+				pos: position{line: 191, col: 16, offset: 5733},
 				run: (*parser).callonArgWithType1,
 				expr: &seqExpr{
-					pos: position{line: 125, col: 16, offset: 3409},
+					pos: position{line: 191, col: 16, offset: 5733},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 125, col: 16, offset: 3409},
+							pos:   position{line: 191, col: 16, offset: 5733},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 125, col: 21, offset: 3414},
+								pos:  position{line: 191, col: 21, offset: 5738},
 								name: "Id",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 125, col: 24, offset: 3417},
+							pos:  position{line: 191, col: 24, offset: 5741},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 125, col: 26, offset: 3419},
+							pos:  position{line: 191, col: 26, offset: 5743},
 							name: "ColonToken",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 125, col: 37, offset: 3430},
+							pos:  position{line: 191, col: 37, offset: 5754},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 125, col: 39, offset: 3432},
+							pos:   position{line: 191, col: 39, offset: 5756},
 							label: "type_",
 							expr: &ruleRefExpr{
-								pos:  position{line: 125, col: 45, offset: 3438},
+								pos:  position{line: 191, col: 45, offset: 5762},
 								name: "Type",
 							},
 						},
@@ -1011,43 +1247,147 @@ var g = &grammar{
 			leftRecursive: false,
 		},
 		{
-			name: "KeyValue",
-			pos:  position{line: 132, col: 1, offset: 3531},
-			expr: &actionExpr{
-				pos: position{line: 132, col: 13, offset: 3543},
-				run: (*parser).callonKeyValue1,
-				expr: &seqExpr{
-					pos: position{line: 132, col: 13, offset: 3543},
-					exprs: []any{
-						&labeledExpr{
-							pos:   position{line: 132, col: 13, offset: 3543},
-							label: "key",
-							expr: &ruleRefExpr{
-								pos:  position{line: 132, col: 17, offset: 3547},
-								name: "WordToken",
+			name: "Lambda",
+			pos:  position{line: 203, col: 1, offset: 6128},
+			expr: &choiceExpr{
+				pos: position{line: 203, col: 11, offset: 6138},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 203, col: 11, offset: 6138},
+						run: (*parser).callonLambda2,
+						expr: &seqExpr{
+							pos: position{line: 203, col: 11, offset: 6138},
+							exprs: []any{
+								&ruleRefExpr{
+									pos:  position{line: 203, col: 11, offset: 6138},
+									name: "FnToken",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 203, col: 19, offset: 6146},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 203, col: 21, offset: 6148},
+									label: "args",
+									expr: &ruleRefExpr{
+										pos:  position{line: 203, col: 26, offset: 6153},
+										name: "LambdaArgs",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 203, col: 37, offset: 6164},
+									name: "_",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 203, col: 39, offset: 6166},
+									name: "ColonToken",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 203, col: 50, offset: 6177},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 203, col: 52, offset: 6179},
+									label: "type_",
+									expr: &ruleRefExpr{
+										pos:  position{line: 203, col: 58, offset: 6185},
+										name: "Type",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 203, col: 63, offset: 6190},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 203, col: 65, offset: 6192},
+									label: "block",
+									expr: &ruleRefExpr{
+										pos:  position{line: 203, col: 71, offset: 6198},
+										name: "Block",
+									},
+								},
 							},
 						},
-						&ruleRefExpr{
-							pos:  position{line: 132, col: 27, offset: 3557},
-							name: "ColonToken",
-						},
-						&ruleRefExpr{
-							pos:  position{line: 132, col: 38, offset: 3568},
-							name: "_",
-						},
-						&labeledExpr{
-							pos:   position{line: 132, col: 40, offset: 3570},
-							label: "value",
-							expr: &ruleRefExpr{
-								pos:  position{line: 132, col: 46, offset: 3576},
-								name: "Form",
+					},
+					&actionExpr{
+						pos: position{line: 210, col: 5, offset: 6336},
+						run: (*parser).callonLambda16,
+						expr: &seqExpr{
+							pos: position{line: 210, col: 5, offset: 6336},
+							exprs: []any{
+								&ruleRefExpr{
+									pos:  position{line: 210, col: 5, offset: 6336},
+									name: "FnToken",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 210, col: 13, offset: 6344},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 210, col: 15, offset: 6346},
+									label: "args",
+									expr: &ruleRefExpr{
+										pos:  position{line: 210, col: 20, offset: 6351},
+										name: "LambdaArgs",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 210, col: 31, offset: 6362},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 210, col: 33, offset: 6364},
+									label: "block",
+									expr: &ruleRefExpr{
+										pos:  position{line: 210, col: 39, offset: 6370},
+										name: "Block",
+									},
+								},
 							},
 						},
-						&zeroOrOneExpr{
-							pos: position{line: 132, col: 51, offset: 3581},
-							expr: &ruleRefExpr{
-								pos:  position{line: 132, col: 51, offset: 3581},
-								name: "CommaToken",
+					},
+					&actionExpr{
+						pos: position{line: 216, col: 5, offset: 6481},
+						run: (*parser).callonLambda25,
+						expr: &seqExpr{
+							pos: position{line: 216, col: 5, offset: 6481},
+							exprs: []any{
+								&ruleRefExpr{
+									pos:  position{line: 216, col: 5, offset: 6481},
+									name: "FnToken",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 216, col: 13, offset: 6489},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 216, col: 15, offset: 6491},
+									label: "args",
+									expr: &ruleRefExpr{
+										pos:  position{line: 216, col: 20, offset: 6496},
+										name: "LambdaArgs",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 216, col: 31, offset: 6507},
+									name: "_",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 216, col: 33, offset: 6509},
+									name: "ArrowToken",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 216, col: 44, offset: 6520},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 216, col: 46, offset: 6522},
+									label: "form",
+									expr: &ruleRefExpr{
+										pos:  position{line: 216, col: 51, offset: 6527},
+										name: "Form",
+									},
+								},
 							},
 						},
 					},
@@ -1057,37 +1397,222 @@ var g = &grammar{
 			leftRecursive: false,
 		},
 		{
-			name: "ColonToken",
-			pos:  position{line: 135, col: 1, offset: 3651},
+			name: "FnToken",
+			pos:  position{line: 223, col: 1, offset: 6633},
 			expr: &litMatcher{
-				pos:        position{line: 135, col: 15, offset: 3665},
-				val:        ":",
+				pos:        position{line: 223, col: 12, offset: 6644},
+				val:        "fn",
 				ignoreCase: false,
-				want:       "\":\"",
+				want:       "\"fn\"",
 			},
 			leader:        false,
 			leftRecursive: false,
 		},
 		{
-			name: "Type",
-			pos:  position{line: 137, col: 1, offset: 3670},
-			expr: &choiceExpr{
-				pos: position{line: 137, col: 9, offset: 3678},
-				alternatives: []any{
-					&ruleRefExpr{
-						pos:  position{line: 137, col: 9, offset: 3678},
-						name: "NonNull",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 137, col: 19, offset: 3688},
-						name: "NamedType",
-					},
+			name: "ArrowToken",
+			pos:  position{line: 224, col: 1, offset: 6649},
+			expr: &litMatcher{
+				pos:        position{line: 224, col: 15, offset: 6663},
+				val:        "->",
+				ignoreCase: false,
+				want:       "\"->\"",
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "LambdaArgs",
+			pos:  position{line: 227, col: 1, offset: 6741},
+			expr: &actionExpr{
+				pos: position{line: 227, col: 15, offset: 6755},
+				run: (*parser).callonLambdaArgs1,
+				expr: &seqExpr{
+					pos: position{line: 227, col: 15, offset: 6755},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 227, col: 15, offset: 6755},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 227, col: 19, offset: 6759},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 227, col: 21, offset: 6761},
+							label: "args",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 227, col: 26, offset: 6766},
+								expr: &ruleRefExpr{
+									pos:  position{line: 227, col: 26, offset: 6766},
+									name: "LambdaArg",
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 227, col: 37, offset: 6777},
+							name: "_",
+						},
+						&litMatcher{
+							pos:        position{line: 227, col: 39, offset: 6779},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "LambdaArg",
+			pos:  position{line: 230, col: 1, offset: 6825},
+			expr: &actionExpr{
+				pos: position{line: 230, col: 14, offset: 6838},
+				run: (*parser).callonLambdaArg1,
+				expr: &seqExpr{
+					pos: position{line: 230, col: 14, offset: 6838},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 230, col: 14, offset: 6838},
+							label: "slot",
+							expr: &choiceExpr{
+								pos: position{line: 230, col: 20, offset: 6844},
+								alternatives: []any{
+									&ruleRefExpr{
+										pos:  position{line: 230, col: 20, offset: 6844},
+										name: "ArgWithDefault",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 230, col: 37, offset: 6861},
+										name: "ArgWithBlockDefault",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 230, col: 59, offset: 6883},
+										name: "ArgWithType",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 230, col: 73, offset: 6897},
+										name: "ArgWithoutType",
+									},
+								},
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 230, col: 89, offset: 6913},
+							expr: &ruleRefExpr{
+								pos:  position{line: 230, col: 89, offset: 6913},
+								name: "CommaToken",
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "ArgWithoutType",
+			pos:  position{line: 233, col: 1, offset: 6959},
+			expr: &actionExpr{
+				pos: position{line: 233, col: 19, offset: 6977},
+				run: (*parser).callonArgWithoutType1,
+				expr: &labeledExpr{
+					pos:   position{line: 233, col: 19, offset: 6977},
+					label: "name",
+					expr: &ruleRefExpr{
+						pos:  position{line: 233, col: 24, offset: 6982},
+						name: "Id",
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "KeyValue",
+			pos:  position{line: 241, col: 1, offset: 7095},
+			expr: &actionExpr{
+				pos: position{line: 241, col: 13, offset: 7107},
+				run: (*parser).callonKeyValue1,
+				expr: &seqExpr{
+					pos: position{line: 241, col: 13, offset: 7107},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 241, col: 13, offset: 7107},
+							label: "key",
+							expr: &ruleRefExpr{
+								pos:  position{line: 241, col: 17, offset: 7111},
+								name: "WordToken",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 241, col: 27, offset: 7121},
+							name: "ColonToken",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 241, col: 38, offset: 7132},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 241, col: 40, offset: 7134},
+							label: "value",
+							expr: &ruleRefExpr{
+								pos:  position{line: 241, col: 46, offset: 7140},
+								name: "Form",
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 241, col: 51, offset: 7145},
+							expr: &ruleRefExpr{
+								pos:  position{line: 241, col: 51, offset: 7145},
+								name: "CommaToken",
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "ColonToken",
+			pos:  position{line: 244, col: 1, offset: 7215},
+			expr: &litMatcher{
+				pos:        position{line: 244, col: 15, offset: 7229},
+				val:        ":",
+				ignoreCase: false,
+				want:       "\":\"",
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "Type",
+			pos:  position{line: 246, col: 1, offset: 7234},
+			expr: &choiceExpr{
+				pos: position{line: 246, col: 9, offset: 7242},
+				alternatives: []any{
+					&ruleRefExpr{
+						pos:  position{line: 246, col: 9, offset: 7242},
+						name: "NonNull",
+					},
 					&ruleRefExpr{
-						pos:  position{line: 137, col: 31, offset: 3700},
+						pos:  position{line: 246, col: 19, offset: 7252},
+						name: "NamedType",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 246, col: 31, offset: 7264},
 						name: "ListType",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 137, col: 42, offset: 3711},
+						pos:  position{line: 246, col: 42, offset: 7275},
+						name: "FunType",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 246, col: 52, offset: 7285},
 						name: "TypeVariable",
 					},
 				},
@@ -1097,15 +1622,15 @@ var g = &grammar{
 		},
 		{
 			name: "NamedType",
-			pos:  position{line: 138, col: 1, offset: 3724},
+			pos:  position{line: 247, col: 1, offset: 7298},
 			expr: &actionExpr{
-				pos: position{line: 138, col: 14, offset: 3737},
+				pos: position{line: 247, col: 14, offset: 7311},
 				run: (*parser).callonNamedType1,
 				expr: &labeledExpr{
-					pos:   position{line: 138, col: 14, offset: 3737},
+					pos:   position{line: 247, col: 14, offset: 7311},
 					label: "name",
 					expr: &ruleRefExpr{
-						pos:  position{line: 138, col: 19, offset: 3742},
+						pos:  position{line: 247, col: 19, offset: 7316},
 						name: "UpperId",
 					},
 				},
@@ -1115,29 +1640,29 @@ var g = &grammar{
 		},
 		{
 			name: "ListType",
-			pos:  position{line: 141, col: 1, offset: 3797},
+			pos:  position{line: 250, col: 1, offset: 7380},
 			expr: &actionExpr{
-				pos: position{line: 141, col: 13, offset: 3809},
+				pos: position{line: 250, col: 13, offset: 7392},
 				run: (*parser).callonListType1,
 				expr: &seqExpr{
-					pos: position{line: 141, col: 13, offset: 3809},
+					pos: position{line: 250, col: 13, offset: 7392},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 141, col: 13, offset: 3809},
+							pos:        position{line: 250, col: 13, offset: 7392},
 							val:        "[",
 							ignoreCase: false,
 							want:       "\"[\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 141, col: 17, offset: 3813},
+							pos:   position{line: 250, col: 17, offset: 7396},
 							label: "inner",
 							expr: &ruleRefExpr{
-								pos:  position{line: 141, col: 23, offset: 3819},
+								pos:  position{line: 250, col: 23, offset: 7402},
 								name: "Type",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 141, col: 28, offset: 3824},
+							pos:        position{line: 250, col: 28, offset: 7407},
 							val:        "]",
 							ignoreCase: false,
 							want:       "\"]\"",
@@ -1150,23 +1675,23 @@ var g = &grammar{
 		},
 		{
 			name: "NonNull",
-			pos:  position{line: 144, col: 1, offset: 3877},
+			pos:  position{line: 253, col: 1, offset: 7469},
 			expr: &actionExpr{
-				pos: position{line: 144, col: 12, offset: 3888},
+				pos: position{line: 253, col: 12, offset: 7480},
 				run: (*parser).callonNonNull1,
 				expr: &seqExpr{
-					pos: position{line: 144, col: 12, offset: 3888},
+					pos: position{line: 253, col: 12, offset: 7480},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 144, col: 12, offset: 3888},
+							pos:   position{line: 253, col: 12, offset: 7480},
 							label: "inner",
 							expr: &ruleRefExpr{
-								pos:  position{line: 144, col: 18, offset: 3894},
+								pos:  position{line: 253, col: 18, offset: 7486},
 								name: "Type",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 144, col: 23, offset: 3899},
+							pos:  position{line: 253, col: 23, offset: 7491},
 							name: "BangToken",
 						},
 					},
@@ -1175,22 +1700,69 @@ var g = &grammar{
 			leader:        true,
 			leftRecursive: true,
 		},
+		{
+			name: "FunType",
+			pos:  position{line: 256, col: 1, offset: 7562},
+			expr: &actionExpr{
+				pos: position{line: 256, col: 12, offset: 7573},
+				run: (*parser).callonFunType1,
+				expr: &seqExpr{
+					pos: position{line: 256, col: 12, offset: 7573},
+					exprs: []any{
+						&ruleRefExpr{
+							pos:  position{line: 256, col: 12, offset: 7573},
+							name: "FnToken",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 256, col: 20, offset: 7581},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 256, col: 22, offset: 7583},
+							label: "args",
+							expr: &ruleRefExpr{
+								pos:  position{line: 256, col: 27, offset: 7588},
+								name: "ArgTypes",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 256, col: 36, offset: 7597},
+							name: "_",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 256, col: 38, offset: 7599},
+							name: "ColonToken",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 256, col: 49, offset: 7610},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 256, col: 51, offset: 7612},
+							label: "ret",
+							expr: &ruleRefExpr{
+								pos:  position{line: 256, col: 55, offset: 7616},
+								name: "Type",
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
 		{
 			name: "TypeVariable",
-			pos:  position{line: 147, col: 1, offset: 3961},
+			pos:  position{line: 259, col: 1, offset: 7695},
 			expr: &actionExpr{
-				pos: position{line: 147, col: 17, offset: 3977},
+				pos: position{line: 259, col: 17, offset: 7711},
 				run: (*parser).callonTypeVariable1,
-				expr: &labeledExpr{
-					pos:   position{line: 147, col: 17, offset: 3977},
-					label: "v",
-					expr: &charClassMatcher{
-						pos:        position{line: 147, col: 19, offset: 3979},
-						val:        "[a-z]",
-						ranges:     []rune{'a', 'z'},
-						ignoreCase: false,
-						inverted:   false,
-					},
+				expr: &charClassMatcher{
+					pos:        position{line: 259, col: 17, offset: 7711},
+					val:        "[a-z]",
+					ranges:     []rune{'a', 'z'},
+					ignoreCase: false,
+					inverted:   false,
 				},
 			},
 			leader:        false,
@@ -1198,9 +1770,9 @@ var g = &grammar{
 		},
 		{
 			name: "BangToken",
-			pos:  position{line: 151, col: 1, offset: 4031},
+			pos:  position{line: 263, col: 1, offset: 7773},
 			expr: &litMatcher{
-				pos:        position{line: 151, col: 14, offset: 4044},
+				pos:        position{line: 263, col: 14, offset: 7786},
 				val:        "!",
 				ignoreCase: false,
 				want:       "\"!\"",
@@ -1210,22 +1782,22 @@ var g = &grammar{
 		},
 		{
 			name: "CommaToken",
-			pos:  position{line: 153, col: 1, offset: 4049},
+			pos:  position{line: 265, col: 1, offset: 7791},
 			expr: &seqExpr{
-				pos: position{line: 153, col: 15, offset: 4063},
+				pos: position{line: 265, col: 15, offset: 7805},
 				exprs: []any{
 					&ruleRefExpr{
-						pos:  position{line: 153, col: 15, offset: 4063},
+						pos:  position{line: 265, col: 15, offset: 7805},
 						name: "_",
 					},
 					&litMatcher{
-						pos:        position{line: 153, col: 17, offset: 4065},
+						pos:        position{line: 265, col: 17, offset: 7807},
 						val:        ",",
 						ignoreCase: false,
 						want:       "\",\"",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 153, col: 21, offset: 4069},
+						pos:  position{line: 265, col: 21, offset: 7811},
 						name: "_",
 					},
 				},
@@ -1235,62 +1807,71 @@ var g = &grammar{
 		},
 		{
 			name: "Infix",
-			pos:  position{line: 155, col: 1, offset: 4072},
+			pos:  position{line: 269, col: 1, offset: 7951},
 			expr: &ruleRefExpr{
-				pos:  position{line: 155, col: 10, offset: 4081},
+				pos:  position{line: 269, col: 10, offset: 7960},
 				name: "Default",
 			},
-			leader:        true,
-			leftRecursive: true,
+			leader:        false,
+			leftRecursive: false,
 		},
 		{
 			name: "Default",
-			pos:  position{line: 156, col: 1, offset: 4089},
-			expr: &actionExpr{
-				pos: position{line: 156, col: 12, offset: 4100},
-				run: (*parser).callonDefault1,
-				expr: &seqExpr{
-					pos: position{line: 156, col: 12, offset: 4100},
-					exprs: []any{
-						&labeledExpr{
-							pos:   position{line: 156, col: 12, offset: 4100},
-							label: "left",
-							expr: &ruleRefExpr{
-								pos:  position{line: 156, col: 17, offset: 4105},
-								name: "Form",
-							},
-						},
-						&ruleRefExpr{
-							pos:  position{line: 156, col: 22, offset: 4110},
-							name: "_",
-						},
-						&ruleRefExpr{
-							pos:  position{line: 156, col: 24, offset: 4112},
-							name: "InterroToken",
-						},
-						&ruleRefExpr{
-							pos:  position{line: 156, col: 37, offset: 4125},
-							name: "_",
-						},
-						&labeledExpr{
-							pos:   position{line: 156, col: 39, offset: 4127},
-							label: "right",
-							expr: &ruleRefExpr{
-								pos:  position{line: 156, col: 45, offset: 4133},
-								name: "Term",
+			pos:  position{line: 270, col: 1, offset: 7968},
+			expr: &choiceExpr{
+				pos: position{line: 270, col: 12, offset: 7979},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 270, col: 12, offset: 7979},
+						run: (*parser).callonDefault2,
+						expr: &seqExpr{
+							pos: position{line: 270, col: 12, offset: 7979},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 270, col: 12, offset: 7979},
+									label: "left",
+									expr: &ruleRefExpr{
+										pos:  position{line: 270, col: 17, offset: 7984},
+										name: "Default",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 270, col: 25, offset: 7992},
+									name: "__",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 270, col: 28, offset: 7995},
+									name: "InterroToken",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 270, col: 41, offset: 8008},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 270, col: 43, offset: 8010},
+									label: "right",
+									expr: &ruleRefExpr{
+										pos:  position{line: 270, col: 49, offset: 8016},
+										name: "Or",
+									},
+								},
 							},
 						},
 					},
+					&ruleRefExpr{
+						pos:  position{line: 272, col: 5, offset: 8083},
+						name: "Or",
+					},
 				},
 			},
-			leader:        false,
+			leader:        true,
 			leftRecursive: true,
 		},
 		{
 			name: "InterroToken",
-			pos:  position{line: 159, col: 1, offset: 4191},
+			pos:  position{line: 273, col: 1, offset: 8086},
 			expr: &litMatcher{
-				pos:        position{line: 159, col: 17, offset: 4207},
+				pos:        position{line: 273, col: 17, offset: 8102},
 				val:        "?",
 				ignoreCase: false,
 				want:       "\"?\"",
@@ -1299,124 +1880,1184 @@ var g = &grammar{
 			leftRecursive: false,
 		},
 		{
-			name: "Select",
-			pos:  position{line: 161, col: 1, offset: 4212},
-			expr: &actionExpr{
-				pos: position{line: 161, col: 11, offset: 4222},
-				run: (*parser).callonSelect1,
-				expr: &seqExpr{
-					pos: position{line: 161, col: 11, offset: 4222},
-					exprs: []any{
-						&labeledExpr{
-							pos:   position{line: 161, col: 11, offset: 4222},
-							label: "left",
-							expr: &ruleRefExpr{
-								pos:  position{line: 161, col: 16, offset: 4227},
-								name: "Term",
+			name: "Or",
+			pos:  position{line: 275, col: 1, offset: 8107},
+			expr: &choiceExpr{
+				pos: position{line: 275, col: 7, offset: 8113},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 275, col: 7, offset: 8113},
+						run: (*parser).callonOr2,
+						expr: &seqExpr{
+							pos: position{line: 275, col: 7, offset: 8113},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 275, col: 7, offset: 8113},
+									label: "left",
+									expr: &ruleRefExpr{
+										pos:  position{line: 275, col: 12, offset: 8118},
+										name: "Or",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 275, col: 15, offset: 8121},
+									name: "__",
+								},
+								&labeledExpr{
+									pos:   position{line: 275, col: 18, offset: 8124},
+									label: "op",
+									expr: &ruleRefExpr{
+										pos:  position{line: 275, col: 21, offset: 8127},
+										name: "OrToken",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 275, col: 29, offset: 8135},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 275, col: 31, offset: 8137},
+									label: "right",
+									expr: &ruleRefExpr{
+										pos:  position{line: 275, col: 37, offset: 8143},
+										name: "And",
+									},
+								},
 							},
 						},
-						&ruleRefExpr{
-							pos:  position{line: 161, col: 21, offset: 4232},
-							name: "_",
-						},
-						&ruleRefExpr{
-							pos:  position{line: 161, col: 23, offset: 4234},
-							name: "DotToken",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 277, col: 5, offset: 8233},
+						name: "And",
+					},
+				},
+			},
+			leader:        true,
+			leftRecursive: true,
+		},
+		{
+			name: "OrToken",
+			pos:  position{line: 278, col: 1, offset: 8237},
+			expr: &litMatcher{
+				pos:        position{line: 278, col: 12, offset: 8248},
+				val:        "||",
+				ignoreCase: false,
+				want:       "\"||\"",
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "And",
+			pos:  position{line: 280, col: 1, offset: 8254},
+			expr: &choiceExpr{
+				pos: position{line: 280, col: 8, offset: 8261},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 280, col: 8, offset: 8261},
+						run: (*parser).callonAnd2,
+						expr: &seqExpr{
+							pos: position{line: 280, col: 8, offset: 8261},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 280, col: 8, offset: 8261},
+									label: "left",
+									expr: &ruleRefExpr{
+										pos:  position{line: 280, col: 13, offset: 8266},
+										name: "And",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 280, col: 17, offset: 8270},
+									name: "__",
+								},
+								&labeledExpr{
+									pos:   position{line: 280, col: 20, offset: 8273},
+									label: "op",
+									expr: &ruleRefExpr{
+										pos:  position{line: 280, col: 23, offset: 8276},
+										name: "AndToken",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 280, col: 32, offset: 8285},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 280, col: 34, offset: 8287},
+									label: "right",
+									expr: &ruleRefExpr{
+										pos:  position{line: 280, col: 40, offset: 8293},
+										name: "Equality",
+									},
+								},
+							},
+						},
+					},
+					&ruleRefExpr{
+						pos:  position{line: 282, col: 5, offset: 8388},
+						name: "Equality",
+					},
+				},
+			},
+			leader:        true,
+			leftRecursive: true,
+		},
+		{
+			name: "AndToken",
+			pos:  position{line: 283, col: 1, offset: 8397},
+			expr: &litMatcher{
+				pos:        position{line: 283, col: 13, offset: 8409},
+				val:        "&&",
+				ignoreCase: false,
+				want:       "\"&&\"",
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "Equality",
+			pos:  position{line: 285, col: 1, offset: 8415},
+			expr: &choiceExpr{
+				pos: position{line: 285, col: 13, offset: 8427},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 285, col: 13, offset: 8427},
+						run: (*parser).callonEquality2,
+						expr: &seqExpr{
+							pos: position{line: 285, col: 13, offset: 8427},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 285, col: 13, offset: 8427},
+									label: "left",
+									expr: &ruleRefExpr{
+										pos:  position{line: 285, col: 18, offset: 8432},
+										name: "Equality",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 285, col: 27, offset: 8441},
+									name: "__",
+								},
+								&labeledExpr{
+									pos:   position{line: 285, col: 30, offset: 8444},
+									label: "op",
+									expr: &choiceExpr{
+										pos: position{line: 285, col: 34, offset: 8448},
+										alternatives: []any{
+											&ruleRefExpr{
+												pos:  position{line: 285, col: 34, offset: 8448},
+												name: "EqToken",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 285, col: 44, offset: 8458},
+												name: "NeqToken",
+											},
+										},
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 285, col: 54, offset: 8468},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 285, col: 56, offset: 8470},
+									label: "right",
+									expr: &ruleRefExpr{
+										pos:  position{line: 285, col: 62, offset: 8476},
+										name: "Comparison",
+									},
+								},
+							},
+						},
+					},
+					&ruleRefExpr{
+						pos:  position{line: 287, col: 5, offset: 8573},
+						name: "Comparison",
+					},
+				},
+			},
+			leader:        true,
+			leftRecursive: true,
+		},
+		{
+			name: "EqToken",
+			pos:  position{line: 288, col: 1, offset: 8584},
+			expr: &litMatcher{
+				pos:        position{line: 288, col: 12, offset: 8595},
+				val:        "==",
+				ignoreCase: false,
+				want:       "\"==\"",
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "NeqToken",
+			pos:  position{line: 289, col: 1, offset: 8600},
+			expr: &litMatcher{
+				pos:        position{line: 289, col: 13, offset: 8612},
+				val:        "!=",
+				ignoreCase: false,
+				want:       "\"!=\"",
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "Comparison",
+			pos:  position{line: 291, col: 1, offset: 8618},
+			expr: &choiceExpr{
+				pos: position{line: 291, col: 15, offset: 8632},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 291, col: 15, offset: 8632},
+						run: (*parser).callonComparison2,
+						expr: &seqExpr{
+							pos: position{line: 291, col: 15, offset: 8632},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 291, col: 15, offset: 8632},
+									label: "left",
+									expr: &ruleRefExpr{
+										pos:  position{line: 291, col: 20, offset: 8637},
+										name: "Comparison",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 291, col: 31, offset: 8648},
+									name: "__",
+								},
+								&labeledExpr{
+									pos:   position{line: 291, col: 34, offset: 8651},
+									label: "op",
+									expr: &choiceExpr{
+										pos: position{line: 291, col: 38, offset: 8655},
+										alternatives: []any{
+											&ruleRefExpr{
+												pos:  position{line: 291, col: 38, offset: 8655},
+												name: "LeToken",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 291, col: 48, offset: 8665},
+												name: "GeToken",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 291, col: 58, offset: 8675},
+												name: "LtToken",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 291, col: 68, offset: 8685},
+												name: "GtToken",
+											},
+										},
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 291, col: 77, offset: 8694},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 291, col: 79, offset: 8696},
+									label: "right",
+									expr: &ruleRefExpr{
+										pos:  position{line: 291, col: 85, offset: 8702},
+										name: "Additive",
+									},
+								},
+							},
+						},
+					},
+					&ruleRefExpr{
+						pos:  position{line: 293, col: 5, offset: 8797},
+						name: "Additive",
+					},
+				},
+			},
+			leader:        true,
+			leftRecursive: true,
+		},
+		{
+			name: "LeToken",
+			pos:  position{line: 294, col: 1, offset: 8806},
+			expr: &litMatcher{
+				pos:        position{line: 294, col: 12, offset: 8817},
+				val:        "<=",
+				ignoreCase: false,
+				want:       "\"<=\"",
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "GeToken",
+			pos:  position{line: 295, col: 1, offset: 8822},
+			expr: &litMatcher{
+				pos:        position{line: 295, col: 12, offset: 8833},
+				val:        ">=",
+				ignoreCase: false,
+				want:       "\">=\"",
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "LtToken",
+			pos:  position{line: 296, col: 1, offset: 8838},
+			expr: &litMatcher{
+				pos:        position{line: 296, col: 12, offset: 8849},
+				val:        "<",
+				ignoreCase: false,
+				want:       "\"<\"",
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "GtToken",
+			pos:  position{line: 297, col: 1, offset: 8853},
+			expr: &litMatcher{
+				pos:        position{line: 297, col: 12, offset: 8864},
+				val:        ">",
+				ignoreCase: false,
+				want:       "\">\"",
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "Additive",
+			pos:  position{line: 299, col: 1, offset: 8869},
+			expr: &choiceExpr{
+				pos: position{line: 299, col: 13, offset: 8881},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 299, col: 13, offset: 8881},
+						run: (*parser).callonAdditive2,
+						expr: &seqExpr{
+							pos: position{line: 299, col: 13, offset: 8881},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 299, col: 13, offset: 8881},
+									label: "left",
+									expr: &ruleRefExpr{
+										pos:  position{line: 299, col: 18, offset: 8886},
+										name: "Additive",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 299, col: 27, offset: 8895},
+									name: "__",
+								},
+								&labeledExpr{
+									pos:   position{line: 299, col: 30, offset: 8898},
+									label: "op",
+									expr: &choiceExpr{
+										pos: position{line: 299, col: 34, offset: 8902},
+										alternatives: []any{
+											&ruleRefExpr{
+												pos:  position{line: 299, col: 34, offset: 8902},
+												name: "PlusToken",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 299, col: 46, offset: 8914},
+												name: "MinusToken",
+											},
+										},
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 299, col: 58, offset: 8926},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 299, col: 60, offset: 8928},
+									label: "right",
+									expr: &ruleRefExpr{
+										pos:  position{line: 299, col: 66, offset: 8934},
+										name: "Multiplicative",
+									},
+								},
+							},
+						},
+					},
+					&ruleRefExpr{
+						pos:  position{line: 301, col: 5, offset: 9035},
+						name: "Multiplicative",
+					},
+				},
+			},
+			leader:        true,
+			leftRecursive: true,
+		},
+		{
+			name: "PlusToken",
+			pos:  position{line: 302, col: 1, offset: 9050},
+			expr: &litMatcher{
+				pos:        position{line: 302, col: 14, offset: 9063},
+				val:        "+",
+				ignoreCase: false,
+				want:       "\"+\"",
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "MinusToken",
+			pos:  position{line: 303, col: 1, offset: 9067},
+			expr: &litMatcher{
+				pos:        position{line: 303, col: 15, offset: 9081},
+				val:        "-",
+				ignoreCase: false,
+				want:       "\"-\"",
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "Multiplicative",
+			pos:  position{line: 305, col: 1, offset: 9086},
+			expr: &choiceExpr{
+				pos: position{line: 305, col: 19, offset: 9104},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 305, col: 19, offset: 9104},
+						run: (*parser).callonMultiplicative2,
+						expr: &seqExpr{
+							pos: position{line: 305, col: 19, offset: 9104},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 305, col: 19, offset: 9104},
+									label: "left",
+									expr: &ruleRefExpr{
+										pos:  position{line: 305, col: 24, offset: 9109},
+										name: "Multiplicative",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 305, col: 39, offset: 9124},
+									name: "__",
+								},
+								&labeledExpr{
+									pos:   position{line: 305, col: 42, offset: 9127},
+									label: "op",
+									expr: &choiceExpr{
+										pos: position{line: 305, col: 46, offset: 9131},
+										alternatives: []any{
+											&ruleRefExpr{
+												pos:  position{line: 305, col: 46, offset: 9131},
+												name: "StarToken",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 305, col: 58, offset: 9143},
+												name: "SlashToken",
+											},
+										},
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 305, col: 70, offset: 9155},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 305, col: 72, offset: 9157},
+									label: "right",
+									expr: &ruleRefExpr{
+										pos:  position{line: 305, col: 78, offset: 9163},
+										name: "Unary",
+									},
+								},
+							},
+						},
+					},
+					&ruleRefExpr{
+						pos:  position{line: 307, col: 5, offset: 9255},
+						name: "Unary",
+					},
+				},
+			},
+			leader:        true,
+			leftRecursive: true,
+		},
+		{
+			name: "StarToken",
+			pos:  position{line: 308, col: 1, offset: 9261},
+			expr: &litMatcher{
+				pos:        position{line: 308, col: 14, offset: 9274},
+				val:        "*",
+				ignoreCase: false,
+				want:       "\"*\"",
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "SlashToken",
+			pos:  position{line: 309, col: 1, offset: 9278},
+			expr: &litMatcher{
+				pos:        position{line: 309, col: 15, offset: 9292},
+				val:        "/",
+				ignoreCase: false,
+				want:       "\"/\"",
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "Unary",
+			pos:  position{line: 311, col: 1, offset: 9297},
+			expr: &choiceExpr{
+				pos: position{line: 311, col: 10, offset: 9306},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 311, col: 10, offset: 9306},
+						run: (*parser).callonUnary2,
+						expr: &seqExpr{
+							pos: position{line: 311, col: 10, offset: 9306},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 311, col: 10, offset: 9306},
+									label: "op",
+									expr: &ruleRefExpr{
+										pos:  position{line: 311, col: 13, offset: 9309},
+										name: "NotToken",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 311, col: 22, offset: 9318},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 311, col: 24, offset: 9320},
+									label: "operand",
+									expr: &ruleRefExpr{
+										pos:  position{line: 311, col: 32, offset: 9328},
+										name: "Unary",
+									},
+								},
+							},
+						},
+					},
+					&ruleRefExpr{
+						pos:  position{line: 313, col: 5, offset: 9408},
+						name: "Term",
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "NotToken",
+			pos:  position{line: 314, col: 1, offset: 9413},
+			expr: &litMatcher{
+				pos:        position{line: 314, col: 13, offset: 9425},
+				val:        "!",
+				ignoreCase: false,
+				want:       "\"!\"",
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "Exec",
+			pos:  position{line: 319, col: 1, offset: 9647},
+			expr: &actionExpr{
+				pos: position{line: 319, col: 9, offset: 9655},
+				run: (*parser).callonExec1,
+				expr: &seqExpr{
+					pos: position{line: 319, col: 9, offset: 9655},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 319, col: 9, offset: 9655},
+							label: "left",
+							expr: &ruleRefExpr{
+								pos:  position{line: 319, col: 14, offset: 9660},
+								name: "Term",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 319, col: 19, offset: 9665},
+							name: "_",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 319, col: 21, offset: 9667},
+							name: "DollarToken",
+						},
+						&labeledExpr{
+							pos:   position{line: 319, col: 33, offset: 9679},
+							label: "cmd",
+							expr: &ruleRefExpr{
+								pos:  position{line: 319, col: 37, offset: 9683},
+								name: "ShellCommandToken",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 319, col: 55, offset: 9701},
+							name: "SemicolonToken",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: true,
+		},
+		{
+			name: "DollarToken",
+			pos:  position{line: 340, col: 1, offset: 10326},
+			expr: &litMatcher{
+				pos:        position{line: 340, col: 16, offset: 10341},
+				val:        "$",
+				ignoreCase: false,
+				want:       "\"$\"",
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "SemicolonToken",
+			pos:  position{line: 341, col: 1, offset: 10345},
+			expr: &litMatcher{
+				pos:        position{line: 341, col: 19, offset: 10363},
+				val:        ";",
+				ignoreCase: false,
+				want:       "\";\"",
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "ShellCommandToken",
+			pos:  position{line: 342, col: 1, offset: 10367},
+			expr: &actionExpr{
+				pos: position{line: 342, col: 22, offset: 10388},
+				run: (*parser).callonShellCommandToken1,
+				expr: &zeroOrMoreExpr{
+					pos: position{line: 342, col: 22, offset: 10388},
+					expr: &choiceExpr{
+						pos: position{line: 342, col: 24, offset: 10390},
+						alternatives: []any{
+							&seqExpr{
+								pos: position{line: 342, col: 24, offset: 10390},
+								exprs: []any{
+									&litMatcher{
+										pos:        position{line: 342, col: 24, offset: 10390},
+										val:        "\"",
+										ignoreCase: false,
+										want:       "\"\\\"\"",
+									},
+									&zeroOrMoreExpr{
+										pos: position{line: 342, col: 28, offset: 10394},
+										expr: &choiceExpr{
+											pos: position{line: 342, col: 30, offset: 10396},
+											alternatives: []any{
+												&seqExpr{
+													pos: position{line: 342, col: 30, offset: 10396},
+													exprs: []any{
+														&litMatcher{
+															pos:        position{line: 342, col: 30, offset: 10396},
+															val:        "\\",
+															ignoreCase: false,
+															want:       "\"\\\\\"",
+														},
+														&anyMatcher{
+															line: 342, col: 35, offset: 10401,
+														},
+													},
+												},
+												&charClassMatcher{
+													pos:        position{line: 342, col: 39, offset: 10405},
+													val:        "[^\"\\\\]",
+													chars:      []rune{'"', '\\'},
+													ignoreCase: false,
+													inverted:   true,
+												},
+											},
+										},
+									},
+									&litMatcher{
+										pos:        position{line: 342, col: 49, offset: 10415},
+										val:        "\"",
+										ignoreCase: false,
+										want:       "\"\\\"\"",
+									},
+								},
+							},
+							&seqExpr{
+								pos: position{line: 342, col: 55, offset: 10421},
+								exprs: []any{
+									&litMatcher{
+										pos:        position{line: 342, col: 55, offset: 10421},
+										val:        "'",
+										ignoreCase: false,
+										want:       "\"'\"",
+									},
+									&zeroOrMoreExpr{
+										pos: position{line: 342, col: 59, offset: 10425},
+										expr: &charClassMatcher{
+											pos:        position{line: 342, col: 59, offset: 10425},
+											val:        "[^']",
+											chars:      []rune{'\''},
+											ignoreCase: false,
+											inverted:   true,
+										},
+									},
+									&litMatcher{
+										pos:        position{line: 342, col: 65, offset: 10431},
+										val:        "'",
+										ignoreCase: false,
+										want:       "\"'\"",
+									},
+								},
+							},
+							&seqExpr{
+								pos: position{line: 342, col: 71, offset: 10437},
+								exprs: []any{
+									&litMatcher{
+										pos:        position{line: 342, col: 71, offset: 10437},
+										val:        "\\",
+										ignoreCase: false,
+										want:       "\"\\\\\"",
+									},
+									&anyMatcher{
+										line: 342, col: 76, offset: 10442,
+									},
+								},
+							},
+							&charClassMatcher{
+								pos:        position{line: 342, col: 80, offset: 10446},
+								val:        "[^;\"'\\\\]",
+								chars:      []rune{';', '"', '\'', '\\'},
+								ignoreCase: false,
+								inverted:   true,
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "Select",
+			pos:  position{line: 346, col: 1, offset: 10492},
+			expr: &actionExpr{
+				pos: position{line: 346, col: 11, offset: 10502},
+				run: (*parser).callonSelect1,
+				expr: &seqExpr{
+					pos: position{line: 346, col: 11, offset: 10502},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 346, col: 11, offset: 10502},
+							label: "left",
+							expr: &ruleRefExpr{
+								pos:  position{line: 346, col: 16, offset: 10507},
+								name: "Term",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 346, col: 21, offset: 10512},
+							name: "_",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 346, col: 23, offset: 10514},
+							name: "DotToken",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 346, col: 32, offset: 10523},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 346, col: 34, offset: 10525},
+							label: "name",
+							expr: &ruleRefExpr{
+								pos:  position{line: 346, col: 39, offset: 10530},
+								name: "Id",
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: true,
+		},
+		{
+			name: "DotToken",
+			pos:  position{line: 349, col: 1, offset: 10617},
+			expr: &litMatcher{
+				pos:        position{line: 349, col: 13, offset: 10629},
+				val:        ".",
+				ignoreCase: false,
+				want:       "\".\"",
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "List",
+			pos:  position{line: 351, col: 1, offset: 10634},
+			expr: &actionExpr{
+				pos: position{line: 351, col: 9, offset: 10642},
+				run: (*parser).callonList1,
+				expr: &seqExpr{
+					pos: position{line: 351, col: 9, offset: 10642},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 351, col: 9, offset: 10642},
+							val:        "[",
+							ignoreCase: false,
+							want:       "\"[\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 351, col: 13, offset: 10646},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 351, col: 15, offset: 10648},
+							label: "eles",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 351, col: 20, offset: 10653},
+								expr: &actionExpr{
+									pos: position{line: 351, col: 21, offset: 10654},
+									run: (*parser).callonList7,
+									expr: &seqExpr{
+										pos: position{line: 351, col: 21, offset: 10654},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 351, col: 21, offset: 10654},
+												name: "_",
+											},
+											&labeledExpr{
+												pos:   position{line: 351, col: 23, offset: 10656},
+												label: "e",
+												expr: &ruleRefExpr{
+													pos:  position{line: 351, col: 25, offset: 10658},
+													name: "Form",
+												},
+											},
+											&zeroOrOneExpr{
+												pos: position{line: 351, col: 30, offset: 10663},
+												expr: &ruleRefExpr{
+													pos:  position{line: 351, col: 30, offset: 10663},
+													name: "CommaToken",
+												},
+											},
+											&ruleRefExpr{
+												pos:  position{line: 351, col: 42, offset: 10675},
+												name: "_",
+											},
+										},
+									},
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 351, col: 64, offset: 10697},
+							val:        "]",
+							ignoreCase: false,
+							want:       "\"]\"",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "Record",
+			pos:  position{line: 357, col: 1, offset: 10926},
+			expr: &actionExpr{
+				pos: position{line: 357, col: 11, offset: 10936},
+				run: (*parser).callonRecord1,
+				expr: &seqExpr{
+					pos: position{line: 357, col: 11, offset: 10936},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 357, col: 11, offset: 10936},
+							val:        "{",
+							ignoreCase: false,
+							want:       "\"{\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 357, col: 15, offset: 10940},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 357, col: 17, offset: 10942},
+							label: "fields",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 357, col: 24, offset: 10949},
+								expr: &actionExpr{
+									pos: position{line: 357, col: 25, offset: 10950},
+									run: (*parser).callonRecord7,
+									expr: &seqExpr{
+										pos: position{line: 357, col: 25, offset: 10950},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 357, col: 25, offset: 10950},
+												name: "_",
+											},
+											&labeledExpr{
+												pos:   position{line: 357, col: 27, offset: 10952},
+												label: "kv",
+												expr: &ruleRefExpr{
+													pos:  position{line: 357, col: 30, offset: 10955},
+													name: "KeyValue",
+												},
+											},
+											&ruleRefExpr{
+												pos:  position{line: 357, col: 39, offset: 10964},
+												name: "_",
+											},
+										},
+									},
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 357, col: 62, offset: 10987},
+							val:        "}",
+							ignoreCase: false,
+							want:       "\"}\"",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "Block",
+			pos:  position{line: 361, col: 1, offset: 11077},
+			expr: &actionExpr{
+				pos: position{line: 361, col: 10, offset: 11086},
+				run: (*parser).callonBlock1,
+				expr: &seqExpr{
+					pos: position{line: 361, col: 10, offset: 11086},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 361, col: 10, offset: 11086},
+							val:        "{",
+							ignoreCase: false,
+							want:       "\"{\"",
+						},
+						&labeledExpr{
+							pos:   position{line: 361, col: 14, offset: 11090},
+							label: "es",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 361, col: 17, offset: 11093},
+								expr: &actionExpr{
+									pos: position{line: 361, col: 18, offset: 11094},
+									run: (*parser).callonBlock6,
+									expr: &seqExpr{
+										pos: position{line: 361, col: 18, offset: 11094},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 361, col: 18, offset: 11094},
+												name: "_",
+											},
+											&labeledExpr{
+												pos:   position{line: 361, col: 20, offset: 11096},
+												label: "e",
+												expr: &choiceExpr{
+													pos: position{line: 361, col: 23, offset: 11099},
+													alternatives: []any{
+														&actionExpr{
+															pos: position{line: 361, col: 23, offset: 11099},
+															run: (*parser).callonBlock11,
+															expr: &seqExpr{
+																pos: position{line: 361, col: 23, offset: 11099},
+																exprs: []any{
+																	&labeledExpr{
+																		pos:   position{line: 361, col: 23, offset: 11099},
+																		label: "x",
+																		expr: &ruleRefExpr{
+																			pos:  position{line: 361, col: 25, offset: 11101},
+																			name: "Expr",
+																		},
+																	},
+																	&ruleRefExpr{
+																		pos:  position{line: 361, col: 30, offset: 11106},
+																		name: "Terminator",
+																	},
+																},
+															},
+														},
+														&actionExpr{
+															pos: position{line: 361, col: 61, offset: 11137},
+															run: (*parser).callonBlock16,
+															expr: &seqExpr{
+																pos: position{line: 361, col: 61, offset: 11137},
+																exprs: []any{
+																	&notExpr{
+																		pos: position{line: 361, col: 61, offset: 11137},
+																		expr: &litMatcher{
+																			pos:        position{line: 361, col: 62, offset: 11138},
+																			val:        "}",
+																			ignoreCase: false,
+																			want:       "\"}\"",
+																		},
+																	},
+																	&labeledExpr{
+																		pos:   position{line: 361, col: 66, offset: 11142},
+																		label: "r",
+																		expr: &ruleRefExpr{
+																			pos:  position{line: 361, col: 68, offset: 11144},
+																			name: "Recover",
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 161, col: 32, offset: 4243},
+							pos:  position{line: 361, col: 115, offset: 11191},
 							name: "_",
 						},
-						&labeledExpr{
-							pos:   position{line: 161, col: 34, offset: 4245},
-							label: "name",
-							expr: &ruleRefExpr{
-								pos:  position{line: 161, col: 39, offset: 4250},
-								name: "Id",
-							},
+						&litMatcher{
+							pos:        position{line: 361, col: 117, offset: 11193},
+							val:        "}",
+							ignoreCase: false,
+							want:       "\"}\"",
 						},
 					},
 				},
 			},
 			leader:        false,
-			leftRecursive: true,
+			leftRecursive: false,
 		},
 		{
-			name: "DotToken",
-			pos:  position{line: 164, col: 1, offset: 4306},
-			expr: &litMatcher{
-				pos:        position{line: 164, col: 13, offset: 4318},
-				val:        ".",
-				ignoreCase: false,
-				want:       "\".\"",
+			name: "Parens",
+			pos:  position{line: 367, col: 1, offset: 11301},
+			expr: &actionExpr{
+				pos: position{line: 367, col: 11, offset: 11311},
+				run: (*parser).callonParens1,
+				expr: &seqExpr{
+					pos: position{line: 367, col: 11, offset: 11311},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 367, col: 11, offset: 11311},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 367, col: 15, offset: 11315},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 367, col: 17, offset: 11317},
+							label: "e",
+							expr: &ruleRefExpr{
+								pos:  position{line: 367, col: 19, offset: 11319},
+								name: "Form",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 367, col: 24, offset: 11324},
+							name: "_",
+						},
+						&litMatcher{
+							pos:        position{line: 367, col: 26, offset: 11326},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
 			},
 			leader:        false,
 			leftRecursive: false,
 		},
 		{
-			name: "List",
-			pos:  position{line: 166, col: 1, offset: 4323},
+			name: "Conditional",
+			pos:  position{line: 371, col: 1, offset: 11351},
 			expr: &actionExpr{
-				pos: position{line: 166, col: 9, offset: 4331},
-				run: (*parser).callonList1,
+				pos: position{line: 371, col: 16, offset: 11366},
+				run: (*parser).callonConditional1,
 				expr: &seqExpr{
-					pos: position{line: 166, col: 9, offset: 4331},
+					pos: position{line: 371, col: 16, offset: 11366},
 					exprs: []any{
-						&litMatcher{
-							pos:        position{line: 166, col: 9, offset: 4331},
-							val:        "[",
-							ignoreCase: false,
-							want:       "\"[\"",
+						&ruleRefExpr{
+							pos:  position{line: 371, col: 16, offset: 11366},
+							name: "IfToken",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 166, col: 13, offset: 4335},
+							pos:  position{line: 371, col: 24, offset: 11374},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 166, col: 15, offset: 4337},
-							label: "eles",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 166, col: 20, offset: 4342},
+							pos:   position{line: 371, col: 26, offset: 11376},
+							label: "cond",
+							expr: &ruleRefExpr{
+								pos:  position{line: 371, col: 31, offset: 11381},
+								name: "Form",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 371, col: 36, offset: 11386},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 371, col: 38, offset: 11388},
+							label: "then",
+							expr: &ruleRefExpr{
+								pos:  position{line: 371, col: 43, offset: 11393},
+								name: "Block",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 371, col: 49, offset: 11399},
+							label: "else_",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 371, col: 55, offset: 11405},
 								expr: &actionExpr{
-									pos: position{line: 166, col: 21, offset: 4343},
-									run: (*parser).callonList7,
+									pos: position{line: 371, col: 56, offset: 11406},
+									run: (*parser).callonConditional12,
 									expr: &seqExpr{
-										pos: position{line: 166, col: 21, offset: 4343},
+										pos: position{line: 371, col: 56, offset: 11406},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 166, col: 21, offset: 4343},
+												pos:  position{line: 371, col: 56, offset: 11406},
+												name: "ElseSeparator",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 371, col: 70, offset: 11420},
+												name: "ElseToken",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 371, col: 80, offset: 11430},
 												name: "_",
 											},
 											&labeledExpr{
-												pos:   position{line: 166, col: 23, offset: 4345},
+												pos:   position{line: 371, col: 82, offset: 11432},
 												label: "e",
-												expr: &ruleRefExpr{
-													pos:  position{line: 166, col: 25, offset: 4347},
-													name: "Form",
-												},
-											},
-											&zeroOrOneExpr{
-												pos: position{line: 166, col: 30, offset: 4352},
-												expr: &ruleRefExpr{
-													pos:  position{line: 166, col: 30, offset: 4352},
-													name: "CommaToken",
+												expr: &choiceExpr{
+													pos: position{line: 371, col: 85, offset: 11435},
+													alternatives: []any{
+														&ruleRefExpr{
+															pos:  position{line: 371, col: 85, offset: 11435},
+															name: "Conditional",
+														},
+														&ruleRefExpr{
+															pos:  position{line: 371, col: 99, offset: 11449},
+															name: "Block",
+														},
+													},
 												},
 											},
-											&ruleRefExpr{
-												pos:  position{line: 166, col: 42, offset: 4364},
-												name: "_",
-											},
 										},
 									},
 								},
 							},
 						},
-						&litMatcher{
-							pos:        position{line: 166, col: 64, offset: 4386},
-							val:        "]",
-							ignoreCase: false,
-							want:       "\"]\"",
-						},
 					},
 				},
 			},
@@ -1424,61 +3065,112 @@ var g = &grammar{
 			leftRecursive: false,
 		},
 		{
-			name: "Block",
-			pos:  position{line: 170, col: 1, offset: 4435},
+			name: "IfToken",
+			pos:  position{line: 378, col: 1, offset: 11624},
+			expr: &litMatcher{
+				pos:        position{line: 378, col: 12, offset: 11635},
+				val:        "if",
+				ignoreCase: false,
+				want:       "\"if\"",
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "ElseToken",
+			pos:  position{line: 379, col: 1, offset: 11640},
+			expr: &litMatcher{
+				pos:        position{line: 379, col: 14, offset: 11653},
+				val:        "else",
+				ignoreCase: false,
+				want:       "\"else\"",
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "ElseSeparator",
+			pos:  position{line: 382, col: 1, offset: 11734},
+			expr: &ruleRefExpr{
+				pos:  position{line: 382, col: 18, offset: 11751},
+				name: "_",
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "Case",
+			pos:  position{line: 384, col: 1, offset: 11754},
 			expr: &actionExpr{
-				pos: position{line: 170, col: 10, offset: 4444},
-				run: (*parser).callonBlock1,
+				pos: position{line: 384, col: 9, offset: 11762},
+				run: (*parser).callonCase1,
 				expr: &seqExpr{
-					pos: position{line: 170, col: 10, offset: 4444},
+					pos: position{line: 384, col: 9, offset: 11762},
 					exprs: []any{
+						&ruleRefExpr{
+							pos:  position{line: 384, col: 9, offset: 11762},
+							name: "CaseToken",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 384, col: 19, offset: 11772},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 384, col: 21, offset: 11774},
+							label: "subject",
+							expr: &ruleRefExpr{
+								pos:  position{line: 384, col: 29, offset: 11782},
+								name: "Form",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 384, col: 34, offset: 11787},
+							name: "_",
+						},
 						&litMatcher{
-							pos:        position{line: 170, col: 10, offset: 4444},
+							pos:        position{line: 384, col: 36, offset: 11789},
 							val:        "{",
 							ignoreCase: false,
 							want:       "\"{\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 170, col: 14, offset: 4448},
-							label: "es",
+							pos:   position{line: 384, col: 40, offset: 11793},
+							label: "arms",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 170, col: 17, offset: 4451},
+								pos: position{line: 384, col: 45, offset: 11798},
 								expr: &actionExpr{
-									pos: position{line: 170, col: 18, offset: 4452},
-									run: (*parser).callonBlock6,
+									pos: position{line: 384, col: 46, offset: 11799},
+									run: (*parser).callonCase11,
 									expr: &seqExpr{
-										pos: position{line: 170, col: 18, offset: 4452},
+										pos: position{line: 384, col: 46, offset: 11799},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 170, col: 18, offset: 4452},
+												pos:  position{line: 384, col: 46, offset: 11799},
 												name: "_",
 											},
 											&labeledExpr{
-												pos:   position{line: 170, col: 20, offset: 4454},
-												label: "e",
-												expr: &ruleRefExpr{
-													pos:  position{line: 170, col: 22, offset: 4456},
-													name: "Expr",
-												},
-											},
-											&zeroOrOneExpr{
-												pos: position{line: 170, col: 27, offset: 4461},
+												pos:   position{line: 384, col: 48, offset: 11801},
+												label: "a",
 												expr: &ruleRefExpr{
-													pos:  position{line: 170, col: 27, offset: 4461},
-													name: "CommaToken",
+													pos:  position{line: 384, col: 50, offset: 11803},
+													name: "CaseArm",
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 170, col: 39, offset: 4473},
-												name: "_",
+												pos:  position{line: 384, col: 58, offset: 11811},
+												name: "Terminator",
 											},
 										},
 									},
 								},
 							},
 						},
+						&ruleRefExpr{
+							pos:  position{line: 384, col: 89, offset: 11842},
+							name: "_",
+						},
 						&litMatcher{
-							pos:        position{line: 170, col: 61, offset: 4495},
+							pos:        position{line: 384, col: 91, offset: 11844},
 							val:        "}",
 							ignoreCase: false,
 							want:       "\"}\"",
@@ -1489,17 +3181,143 @@ var g = &grammar{
 			leader:        false,
 			leftRecursive: false,
 		},
+		{
+			name: "CaseToken",
+			pos:  position{line: 387, col: 1, offset: 11920},
+			expr: &litMatcher{
+				pos:        position{line: 387, col: 14, offset: 11933},
+				val:        "case",
+				ignoreCase: false,
+				want:       "\"case\"",
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "CaseArm",
+			pos:  position{line: 388, col: 1, offset: 11940},
+			expr: &choiceExpr{
+				pos: position{line: 388, col: 12, offset: 11951},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 388, col: 12, offset: 11951},
+						run: (*parser).callonCaseArm2,
+						expr: &seqExpr{
+							pos: position{line: 388, col: 12, offset: 11951},
+							exprs: []any{
+								&ruleRefExpr{
+									pos:  position{line: 388, col: 12, offset: 11951},
+									name: "ElseToken",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 388, col: 22, offset: 11961},
+									name: "_",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 388, col: 24, offset: 11963},
+									name: "ArrowToken",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 388, col: 35, offset: 11974},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 388, col: 37, offset: 11976},
+									label: "body",
+									expr: &ruleRefExpr{
+										pos:  position{line: 388, col: 42, offset: 11981},
+										name: "Form",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 390, col: 5, offset: 12059},
+						run: (*parser).callonCaseArm10,
+						expr: &seqExpr{
+							pos: position{line: 390, col: 5, offset: 12059},
+							exprs: []any{
+								&ruleRefExpr{
+									pos:  position{line: 390, col: 5, offset: 12059},
+									name: "NullToken",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 390, col: 15, offset: 12069},
+									name: "_",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 390, col: 17, offset: 12071},
+									name: "ArrowToken",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 390, col: 28, offset: 12082},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 390, col: 30, offset: 12084},
+									label: "body",
+									expr: &ruleRefExpr{
+										pos:  position{line: 390, col: 35, offset: 12089},
+										name: "Form",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 392, col: 5, offset: 12167},
+						run: (*parser).callonCaseArm18,
+						expr: &seqExpr{
+							pos: position{line: 392, col: 5, offset: 12167},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 392, col: 5, offset: 12167},
+									label: "value",
+									expr: &ruleRefExpr{
+										pos:  position{line: 392, col: 11, offset: 12173},
+										name: "WordToken",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 392, col: 21, offset: 12183},
+									name: "_",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 392, col: 23, offset: 12185},
+									name: "ArrowToken",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 392, col: 34, offset: 12196},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 392, col: 36, offset: 12198},
+									label: "body",
+									expr: &ruleRefExpr{
+										pos:  position{line: 392, col: 41, offset: 12203},
+										name: "Form",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
 		{
 			name: "Symbol",
-			pos:  position{line: 176, col: 1, offset: 4594},
+			pos:  position{line: 396, col: 1, offset: 12291},
 			expr: &actionExpr{
-				pos: position{line: 176, col: 11, offset: 4604},
+				pos: position{line: 396, col: 11, offset: 12301},
 				run: (*parser).callonSymbol1,
 				expr: &labeledExpr{
-					pos:   position{line: 176, col: 11, offset: 4604},
+					pos:   position{line: 396, col: 11, offset: 12301},
 					label: "name",
 					expr: &ruleRefExpr{
-						pos:  position{line: 176, col: 16, offset: 4609},
+						pos:  position{line: 396, col: 16, offset: 12306},
 						name: "Id",
 					},
 				},
@@ -1509,28 +3327,36 @@ var g = &grammar{
 		},
 		{
 			name: "Literal",
-			pos:  position{line: 182, col: 1, offset: 4666},
+			pos:  position{line: 402, col: 1, offset: 12372},
 			expr: &choiceExpr{
-				pos: position{line: 182, col: 12, offset: 4677},
+				pos: position{line: 402, col: 12, offset: 12383},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 182, col: 12, offset: 4677},
+						pos:  position{line: 402, col: 12, offset: 12383},
+						name: "Float",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 402, col: 20, offset: 12391},
 						name: "Int",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 182, col: 18, offset: 4683},
+						pos:  position{line: 402, col: 26, offset: 12397},
 						name: "Boolean",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 182, col: 28, offset: 4693},
+						pos:  position{line: 402, col: 36, offset: 12407},
 						name: "String",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 182, col: 37, offset: 4702},
+						pos:  position{line: 402, col: 45, offset: 12416},
 						name: "Quoted",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 182, col: 46, offset: 4711},
+						pos:  position{line: 402, col: 54, offset: 12425},
+						name: "Path",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 402, col: 61, offset: 12432},
 						name: "Null",
 					},
 				},
@@ -1540,30 +3366,30 @@ var g = &grammar{
 		},
 		{
 			name: "Int",
-			pos:  position{line: 184, col: 1, offset: 4717},
-			expr: &choiceExpr{
-				pos: position{line: 184, col: 8, offset: 4724},
-				alternatives: []any{
-					&litMatcher{
-						pos:        position{line: 184, col: 8, offset: 4724},
-						val:        "0",
-						ignoreCase: false,
-						want:       "\"0\"",
-					},
-					&actionExpr{
-						pos: position{line: 184, col: 14, offset: 4730},
-						run: (*parser).callonInt3,
-						expr: &seqExpr{
-							pos: position{line: 184, col: 14, offset: 4730},
+			pos:  position{line: 404, col: 1, offset: 12438},
+			expr: &actionExpr{
+				pos: position{line: 404, col: 8, offset: 12445},
+				run: (*parser).callonInt1,
+				expr: &choiceExpr{
+					pos: position{line: 404, col: 9, offset: 12446},
+					alternatives: []any{
+						&litMatcher{
+							pos:        position{line: 404, col: 9, offset: 12446},
+							val:        "0",
+							ignoreCase: false,
+							want:       "\"0\"",
+						},
+						&seqExpr{
+							pos: position{line: 404, col: 15, offset: 12452},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 184, col: 14, offset: 4730},
+									pos:  position{line: 404, col: 15, offset: 12452},
 									name: "NonZeroDecimalDigit",
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 184, col: 34, offset: 4750},
+									pos: position{line: 404, col: 35, offset: 12472},
 									expr: &ruleRefExpr{
-										pos:  position{line: 184, col: 34, offset: 4750},
+										pos:  position{line: 404, col: 35, offset: 12472},
 										name: "DecimalDigit",
 									},
 								},
@@ -1575,22 +3401,98 @@ var g = &grammar{
 			leader:        false,
 			leftRecursive: false,
 		},
+		{
+			name: "Float",
+			pos:  position{line: 416, col: 1, offset: 12794},
+			expr: &actionExpr{
+				pos: position{line: 416, col: 10, offset: 12803},
+				run: (*parser).callonFloat1,
+				expr: &seqExpr{
+					pos: position{line: 416, col: 10, offset: 12803},
+					exprs: []any{
+						&choiceExpr{
+							pos: position{line: 416, col: 11, offset: 12804},
+							alternatives: []any{
+								&litMatcher{
+									pos:        position{line: 416, col: 11, offset: 12804},
+									val:        "0",
+									ignoreCase: false,
+									want:       "\"0\"",
+								},
+								&seqExpr{
+									pos: position{line: 416, col: 17, offset: 12810},
+									exprs: []any{
+										&ruleRefExpr{
+											pos:  position{line: 416, col: 17, offset: 12810},
+											name: "NonZeroDecimalDigit",
+										},
+										&zeroOrMoreExpr{
+											pos: position{line: 416, col: 37, offset: 12830},
+											expr: &ruleRefExpr{
+												pos:  position{line: 416, col: 37, offset: 12830},
+												name: "DecimalDigit",
+											},
+										},
+									},
+								},
+							},
+						},
+						&choiceExpr{
+							pos: position{line: 416, col: 53, offset: 12846},
+							alternatives: []any{
+								&seqExpr{
+									pos: position{line: 416, col: 53, offset: 12846},
+									exprs: []any{
+										&litMatcher{
+											pos:        position{line: 416, col: 53, offset: 12846},
+											val:        ".",
+											ignoreCase: false,
+											want:       "\".\"",
+										},
+										&oneOrMoreExpr{
+											pos: position{line: 416, col: 57, offset: 12850},
+											expr: &ruleRefExpr{
+												pos:  position{line: 416, col: 57, offset: 12850},
+												name: "DecimalDigit",
+											},
+										},
+										&zeroOrOneExpr{
+											pos: position{line: 416, col: 71, offset: 12864},
+											expr: &ruleRefExpr{
+												pos:  position{line: 416, col: 71, offset: 12864},
+												name: "Exponent",
+											},
+										},
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 416, col: 83, offset: 12876},
+									name: "Exponent",
+								},
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
 		{
 			name: "Exponent",
-			pos:  position{line: 192, col: 1, offset: 4893},
+			pos:  position{line: 427, col: 1, offset: 13140},
 			expr: &seqExpr{
-				pos: position{line: 192, col: 13, offset: 4905},
+				pos: position{line: 427, col: 13, offset: 13152},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 192, col: 13, offset: 4905},
+						pos:        position{line: 427, col: 13, offset: 13152},
 						val:        "e",
 						ignoreCase: true,
 						want:       "\"e\"i",
 					},
 					&zeroOrOneExpr{
-						pos: position{line: 192, col: 18, offset: 4910},
+						pos: position{line: 427, col: 18, offset: 13157},
 						expr: &charClassMatcher{
-							pos:        position{line: 192, col: 18, offset: 4910},
+							pos:        position{line: 427, col: 18, offset: 13157},
 							val:        "[+-]",
 							chars:      []rune{'+', '-'},
 							ignoreCase: false,
@@ -1598,9 +3500,9 @@ var g = &grammar{
 						},
 					},
 					&oneOrMoreExpr{
-						pos: position{line: 192, col: 24, offset: 4916},
+						pos: position{line: 427, col: 24, offset: 13163},
 						expr: &ruleRefExpr{
-							pos:  position{line: 192, col: 24, offset: 4916},
+							pos:  position{line: 427, col: 24, offset: 13163},
 							name: "DecimalDigit",
 						},
 					},
@@ -1611,59 +3513,41 @@ var g = &grammar{
 		},
 		{
 			name: "String",
-			pos:  position{line: 194, col: 1, offset: 4931},
+			pos:  position{line: 429, col: 1, offset: 13178},
 			expr: &actionExpr{
-				pos: position{line: 194, col: 11, offset: 4941},
+				pos: position{line: 429, col: 11, offset: 13188},
 				run: (*parser).callonString1,
 				expr: &seqExpr{
-					pos: position{line: 194, col: 11, offset: 4941},
+					pos: position{line: 429, col: 11, offset: 13188},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 194, col: 11, offset: 4941},
+							pos:        position{line: 429, col: 11, offset: 13188},
 							val:        "\"",
 							ignoreCase: false,
 							want:       "\"\\\"\"",
 						},
-						&zeroOrMoreExpr{
-							pos: position{line: 194, col: 15, offset: 4945},
-							expr: &choiceExpr{
-								pos: position{line: 194, col: 17, offset: 4947},
-								alternatives: []any{
-									&seqExpr{
-										pos: position{line: 194, col: 17, offset: 4947},
-										exprs: []any{
-											&notExpr{
-												pos: position{line: 194, col: 17, offset: 4947},
-												expr: &ruleRefExpr{
-													pos:  position{line: 194, col: 18, offset: 4948},
-													name: "EscapedChar",
-												},
-											},
-											&anyMatcher{
-												line: 194, col: 30, offset: 4960,
-											},
+						&labeledExpr{
+							pos:   position{line: 429, col: 15, offset: 13192},
+							label: "parts",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 429, col: 21, offset: 13198},
+								expr: &choiceExpr{
+									pos: position{line: 429, col: 22, offset: 13199},
+									alternatives: []any{
+										&ruleRefExpr{
+											pos:  position{line: 429, col: 22, offset: 13199},
+											name: "StringInterpolation",
 										},
-									},
-									&seqExpr{
-										pos: position{line: 194, col: 34, offset: 4964},
-										exprs: []any{
-											&litMatcher{
-												pos:        position{line: 194, col: 34, offset: 4964},
-												val:        "\\",
-												ignoreCase: false,
-												want:       "\"\\\\\"",
-											},
-											&ruleRefExpr{
-												pos:  position{line: 194, col: 39, offset: 4969},
-												name: "EscapeSequence",
-											},
+										&ruleRefExpr{
+											pos:  position{line: 429, col: 44, offset: 13221},
+											name: "StringCharsToken",
 										},
 									},
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 194, col: 57, offset: 4987},
+							pos:        position{line: 429, col: 63, offset: 13240},
 							val:        "\"",
 							ignoreCase: false,
 							want:       "\"\\\"\"",
@@ -1674,11 +3558,111 @@ var g = &grammar{
 			leader:        false,
 			leftRecursive: false,
 		},
+		{
+			name: "StringInterpolation",
+			pos:  position{line: 442, col: 1, offset: 13517},
+			expr: &actionExpr{
+				pos: position{line: 442, col: 24, offset: 13540},
+				run: (*parser).callonStringInterpolation1,
+				expr: &seqExpr{
+					pos: position{line: 442, col: 24, offset: 13540},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 442, col: 24, offset: 13540},
+							val:        "${",
+							ignoreCase: false,
+							want:       "\"${\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 442, col: 29, offset: 13545},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 442, col: 31, offset: 13547},
+							label: "e",
+							expr: &ruleRefExpr{
+								pos:  position{line: 442, col: 33, offset: 13549},
+								name: "Form",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 442, col: 38, offset: 13554},
+							name: "_",
+						},
+						&litMatcher{
+							pos:        position{line: 442, col: 40, offset: 13556},
+							val:        "}",
+							ignoreCase: false,
+							want:       "\"}\"",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "StringCharsToken",
+			pos:  position{line: 446, col: 1, offset: 13581},
+			expr: &actionExpr{
+				pos: position{line: 446, col: 21, offset: 13601},
+				run: (*parser).callonStringCharsToken1,
+				expr: &oneOrMoreExpr{
+					pos: position{line: 446, col: 21, offset: 13601},
+					expr: &choiceExpr{
+						pos: position{line: 446, col: 23, offset: 13603},
+						alternatives: []any{
+							&seqExpr{
+								pos: position{line: 446, col: 23, offset: 13603},
+								exprs: []any{
+									&notExpr{
+										pos: position{line: 446, col: 23, offset: 13603},
+										expr: &ruleRefExpr{
+											pos:  position{line: 446, col: 24, offset: 13604},
+											name: "EscapedChar",
+										},
+									},
+									&notExpr{
+										pos: position{line: 446, col: 36, offset: 13616},
+										expr: &litMatcher{
+											pos:        position{line: 446, col: 37, offset: 13617},
+											val:        "${",
+											ignoreCase: false,
+											want:       "\"${\"",
+										},
+									},
+									&anyMatcher{
+										line: 446, col: 42, offset: 13622,
+									},
+								},
+							},
+							&seqExpr{
+								pos: position{line: 446, col: 46, offset: 13626},
+								exprs: []any{
+									&litMatcher{
+										pos:        position{line: 446, col: 46, offset: 13626},
+										val:        "\\",
+										ignoreCase: false,
+										want:       "\"\\\\\"",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 446, col: 51, offset: 13631},
+										name: "EscapeSequence",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
 		{
 			name: "EscapedChar",
-			pos:  position{line: 203, col: 1, offset: 5178},
+			pos:  position{line: 456, col: 1, offset: 13914},
 			expr: &charClassMatcher{
-				pos:        position{line: 203, col: 16, offset: 5193},
+				pos:        position{line: 456, col: 16, offset: 13929},
 				val:        "[\\x00-\\x1f\"\\\\]",
 				chars:      []rune{'"', '\\'},
 				ranges:     []rune{'\x00', '\x1f'},
@@ -1690,16 +3674,16 @@ var g = &grammar{
 		},
 		{
 			name: "EscapeSequence",
-			pos:  position{line: 205, col: 1, offset: 5209},
+			pos:  position{line: 458, col: 1, offset: 13945},
 			expr: &choiceExpr{
-				pos: position{line: 205, col: 19, offset: 5227},
+				pos: position{line: 458, col: 19, offset: 13963},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 205, col: 19, offset: 5227},
+						pos:  position{line: 458, col: 19, offset: 13963},
 						name: "SingleCharEscape",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 205, col: 38, offset: 5246},
+						pos:  position{line: 458, col: 38, offset: 13982},
 						name: "UnicodeEscape",
 					},
 				},
@@ -1709,11 +3693,11 @@ var g = &grammar{
 		},
 		{
 			name: "SingleCharEscape",
-			pos:  position{line: 207, col: 1, offset: 5261},
+			pos:  position{line: 460, col: 1, offset: 13997},
 			expr: &charClassMatcher{
-				pos:        position{line: 207, col: 21, offset: 5281},
-				val:        "[\"\\\\/bfnrt]",
-				chars:      []rune{'"', '\\', '/', 'b', 'f', 'n', 'r', 't'},
+				pos:        position{line: 460, col: 21, offset: 14017},
+				val:        "[\"\\\\/bfnrt$]",
+				chars:      []rune{'"', '\\', '/', 'b', 'f', 'n', 'r', 't', '$'},
 				ignoreCase: false,
 				inverted:   false,
 			},
@@ -1722,30 +3706,30 @@ var g = &grammar{
 		},
 		{
 			name: "UnicodeEscape",
-			pos:  position{line: 209, col: 1, offset: 5294},
+			pos:  position{line: 462, col: 1, offset: 14031},
 			expr: &seqExpr{
-				pos: position{line: 209, col: 18, offset: 5311},
+				pos: position{line: 462, col: 18, offset: 14048},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 209, col: 18, offset: 5311},
+						pos:        position{line: 462, col: 18, offset: 14048},
 						val:        "u",
 						ignoreCase: false,
 						want:       "\"u\"",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 209, col: 22, offset: 5315},
+						pos:  position{line: 462, col: 22, offset: 14052},
 						name: "HexDigit",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 209, col: 31, offset: 5324},
+						pos:  position{line: 462, col: 31, offset: 14061},
 						name: "HexDigit",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 209, col: 40, offset: 5333},
+						pos:  position{line: 462, col: 40, offset: 14070},
 						name: "HexDigit",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 209, col: 49, offset: 5342},
+						pos:  position{line: 462, col: 49, offset: 14079},
 						name: "HexDigit",
 					},
 				},
@@ -1755,9 +3739,9 @@ var g = &grammar{
 		},
 		{
 			name: "DecimalDigit",
-			pos:  position{line: 211, col: 1, offset: 5352},
+			pos:  position{line: 464, col: 1, offset: 14089},
 			expr: &charClassMatcher{
-				pos:        position{line: 211, col: 17, offset: 5368},
+				pos:        position{line: 464, col: 17, offset: 14105},
 				val:        "[0-9]",
 				ranges:     []rune{'0', '9'},
 				ignoreCase: false,
@@ -1768,9 +3752,9 @@ var g = &grammar{
 		},
 		{
 			name: "NonZeroDecimalDigit",
-			pos:  position{line: 213, col: 1, offset: 5375},
+			pos:  position{line: 466, col: 1, offset: 14112},
 			expr: &charClassMatcher{
-				pos:        position{line: 213, col: 24, offset: 5398},
+				pos:        position{line: 466, col: 24, offset: 14135},
 				val:        "[1-9]",
 				ranges:     []rune{'1', '9'},
 				ignoreCase: false,
@@ -1781,9 +3765,9 @@ var g = &grammar{
 		},
 		{
 			name: "HexDigit",
-			pos:  position{line: 215, col: 1, offset: 5405},
+			pos:  position{line: 468, col: 1, offset: 14142},
 			expr: &charClassMatcher{
-				pos:        position{line: 215, col: 13, offset: 5417},
+				pos:        position{line: 468, col: 13, offset: 14154},
 				val:        "[0-9a-f]i",
 				ranges:     []rune{'0', '9', 'a', 'f'},
 				ignoreCase: true,
@@ -1794,43 +3778,43 @@ var g = &grammar{
 		},
 		{
 			name: "Quoted",
-			pos:  position{line: 217, col: 1, offset: 5428},
+			pos:  position{line: 470, col: 1, offset: 14165},
 			expr: &actionExpr{
-				pos: position{line: 217, col: 11, offset: 5438},
+				pos: position{line: 470, col: 11, offset: 14175},
 				run: (*parser).callonQuoted1,
 				expr: &seqExpr{
-					pos: position{line: 217, col: 11, offset: 5438},
+					pos: position{line: 470, col: 11, offset: 14175},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 217, col: 11, offset: 5438},
+							pos:        position{line: 470, col: 11, offset: 14175},
 							val:        "%",
 							ignoreCase: false,
 							want:       "\"%\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 217, col: 15, offset: 5442},
+							pos:   position{line: 470, col: 15, offset: 14179},
 							label: "quoter",
 							expr: &ruleRefExpr{
-								pos:  position{line: 217, col: 22, offset: 5449},
+								pos:  position{line: 470, col: 22, offset: 14186},
 								name: "WordToken",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 217, col: 32, offset: 5459},
+							pos:        position{line: 470, col: 32, offset: 14196},
 							val:        "{",
 							ignoreCase: false,
 							want:       "\"{\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 217, col: 36, offset: 5463},
+							pos:   position{line: 470, col: 36, offset: 14200},
 							label: "raw",
 							expr: &ruleRefExpr{
-								pos:  position{line: 217, col: 40, offset: 5467},
-								name: "QuotedRawToken",
+								pos:  position{line: 470, col: 40, offset: 14204},
+								name: "QuotedRaw",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 217, col: 55, offset: 5482},
+							pos:        position{line: 470, col: 50, offset: 14214},
 							val:        "}",
 							ignoreCase: false,
 							want:       "\"}\"",
@@ -1842,44 +3826,224 @@ var g = &grammar{
 			leftRecursive: false,
 		},
 		{
-			name: "QuotedRawToken",
-			pos:  position{line: 223, col: 1, offset: 5555},
+			name: "QuotedRaw",
+			pos:  position{line: 478, col: 1, offset: 14379},
 			expr: &actionExpr{
-				pos: position{line: 223, col: 19, offset: 5573},
-				run: (*parser).callonQuotedRawToken1,
+				pos: position{line: 478, col: 14, offset: 14392},
+				run: (*parser).callonQuotedRaw1,
 				expr: &zeroOrMoreExpr{
-					pos: position{line: 223, col: 19, offset: 5573},
-					expr: &charClassMatcher{
-						pos:        position{line: 223, col: 19, offset: 5573},
-						val:        "[^}]",
-						chars:      []rune{'}'},
-						ignoreCase: false,
-						inverted:   true,
+					pos: position{line: 478, col: 14, offset: 14392},
+					expr: &choiceExpr{
+						pos: position{line: 478, col: 16, offset: 14394},
+						alternatives: []any{
+							&ruleRefExpr{
+								pos:  position{line: 478, col: 16, offset: 14394},
+								name: "QuotedRawToken",
+							},
+							&seqExpr{
+								pos: position{line: 478, col: 33, offset: 14411},
+								exprs: []any{
+									&litMatcher{
+										pos:        position{line: 478, col: 33, offset: 14411},
+										val:        "{",
+										ignoreCase: false,
+										want:       "\"{\"",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 478, col: 37, offset: 14415},
+										name: "QuotedRaw",
+									},
+									&litMatcher{
+										pos:        position{line: 478, col: 47, offset: 14425},
+										val:        "}",
+										ignoreCase: false,
+										want:       "\"}\"",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "QuotedRawToken",
+			pos:  position{line: 481, col: 1, offset: 14465},
+			expr: &oneOrMoreExpr{
+				pos: position{line: 481, col: 19, offset: 14483},
+				expr: &charClassMatcher{
+					pos:        position{line: 481, col: 19, offset: 14483},
+					val:        "[^{}]",
+					chars:      []rune{'{', '}'},
+					ignoreCase: false,
+					inverted:   true,
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "Path",
+			pos:  position{line: 484, col: 1, offset: 14571},
+			expr: &actionExpr{
+				pos: position{line: 484, col: 9, offset: 14579},
+				run: (*parser).callonPath1,
+				expr: &labeledExpr{
+					pos:   position{line: 484, col: 9, offset: 14579},
+					label: "path",
+					expr: &choiceExpr{
+						pos: position{line: 484, col: 15, offset: 14585},
+						alternatives: []any{
+							&ruleRefExpr{
+								pos:  position{line: 484, col: 15, offset: 14585},
+								name: "PathToken",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 484, col: 27, offset: 14597},
+								name: "RelativePathToken",
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "PathToken",
+			pos:  position{line: 490, col: 1, offset: 14764},
+			expr: &actionExpr{
+				pos: position{line: 490, col: 14, offset: 14777},
+				run: (*parser).callonPathToken1,
+				expr: &seqExpr{
+					pos: position{line: 490, col: 14, offset: 14777},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 490, col: 14, offset: 14777},
+							val:        "/",
+							ignoreCase: false,
+							want:       "\"/\"",
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 490, col: 18, offset: 14781},
+							expr: &charClassMatcher{
+								pos:        position{line: 490, col: 18, offset: 14781},
+								val:        "[^ \\t\\r\\n,;(){}[\\]\"'#]",
+								chars:      []rune{' ', '\t', '\r', '\n', ',', ';', '(', ')', '{', '}', '[', ']', '"', '\'', '#'},
+								ignoreCase: false,
+								inverted:   true,
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "RelativePathToken",
+			pos:  position{line: 493, col: 1, offset: 14838},
+			expr: &actionExpr{
+				pos: position{line: 493, col: 22, offset: 14859},
+				run: (*parser).callonRelativePathToken1,
+				expr: &seqExpr{
+					pos: position{line: 493, col: 22, offset: 14859},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 493, col: 22, offset: 14859},
+							val:        ".",
+							ignoreCase: false,
+							want:       "\".\"",
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 493, col: 26, offset: 14863},
+							expr: &litMatcher{
+								pos:        position{line: 493, col: 26, offset: 14863},
+								val:        ".",
+								ignoreCase: false,
+								want:       "\".\"",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 493, col: 31, offset: 14868},
+							val:        "/",
+							ignoreCase: false,
+							want:       "\"/\"",
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 493, col: 35, offset: 14872},
+							expr: &charClassMatcher{
+								pos:        position{line: 493, col: 35, offset: 14872},
+								val:        "[^ \\t\\r\\n,;(){}[\\]\"'#]",
+								chars:      []rune{' ', '\t', '\r', '\n', ',', ';', '(', ')', '{', '}', '[', ']', '"', '\'', '#'},
+								ignoreCase: false,
+								inverted:   true,
+							},
+						},
 					},
 				},
 			},
 			leader:        false,
 			leftRecursive: false,
 		},
+		{
+			name: "Join",
+			pos:  position{line: 498, col: 1, offset: 14994},
+			expr: &actionExpr{
+				pos: position{line: 498, col: 9, offset: 15002},
+				run: (*parser).callonJoin1,
+				expr: &seqExpr{
+					pos: position{line: 498, col: 9, offset: 15002},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 498, col: 9, offset: 15002},
+							label: "dir",
+							expr: &ruleRefExpr{
+								pos:  position{line: 498, col: 13, offset: 15006},
+								name: "Term",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 498, col: 18, offset: 15011},
+							val:        "/",
+							ignoreCase: false,
+							want:       "\"/\"",
+						},
+						&labeledExpr{
+							pos:   position{line: 498, col: 22, offset: 15015},
+							label: "path",
+							expr: &ruleRefExpr{
+								pos:  position{line: 498, col: 27, offset: 15020},
+								name: "RelativePathToken",
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: true,
+		},
 		{
 			name: "Boolean",
-			pos:  position{line: 227, col: 1, offset: 5613},
+			pos:  position{line: 509, col: 1, offset: 15279},
 			expr: &choiceExpr{
-				pos: position{line: 227, col: 12, offset: 5624},
+				pos: position{line: 509, col: 12, offset: 15290},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 227, col: 12, offset: 5624},
+						pos: position{line: 509, col: 12, offset: 15290},
 						run: (*parser).callonBoolean2,
 						expr: &ruleRefExpr{
-							pos:  position{line: 227, col: 12, offset: 5624},
+							pos:  position{line: 509, col: 12, offset: 15290},
 							name: "TrueToken",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 228, col: 12, offset: 5675},
+						pos: position{line: 510, col: 12, offset: 15350},
 						run: (*parser).callonBoolean4,
 						expr: &ruleRefExpr{
-							pos:  position{line: 228, col: 12, offset: 5675},
+							pos:  position{line: 510, col: 12, offset: 15350},
 							name: "FalseToken",
 						},
 					},
@@ -1890,9 +4054,9 @@ var g = &grammar{
 		},
 		{
 			name: "TrueToken",
-			pos:  position{line: 229, col: 1, offset: 5717},
+			pos:  position{line: 511, col: 1, offset: 15401},
 			expr: &litMatcher{
-				pos:        position{line: 229, col: 14, offset: 5730},
+				pos:        position{line: 511, col: 14, offset: 15414},
 				val:        "true",
 				ignoreCase: false,
 				want:       "\"true\"",
@@ -1902,9 +4066,9 @@ var g = &grammar{
 		},
 		{
 			name: "FalseToken",
-			pos:  position{line: 230, col: 1, offset: 5737},
+			pos:  position{line: 512, col: 1, offset: 15421},
 			expr: &litMatcher{
-				pos:        position{line: 230, col: 15, offset: 5751},
+				pos:        position{line: 512, col: 15, offset: 15435},
 				val:        "false",
 				ignoreCase: false,
 				want:       "\"false\"",
@@ -1914,12 +4078,12 @@ var g = &grammar{
 		},
 		{
 			name: "Null",
-			pos:  position{line: 232, col: 1, offset: 5760},
+			pos:  position{line: 514, col: 1, offset: 15444},
 			expr: &actionExpr{
-				pos: position{line: 232, col: 9, offset: 5768},
+				pos: position{line: 514, col: 9, offset: 15452},
 				run: (*parser).callonNull1,
 				expr: &ruleRefExpr{
-					pos:  position{line: 232, col: 9, offset: 5768},
+					pos:  position{line: 514, col: 9, offset: 15452},
 					name: "NullToken",
 				},
 			},
@@ -1928,9 +4092,9 @@ var g = &grammar{
 		},
 		{
 			name: "NullToken",
-			pos:  position{line: 233, col: 1, offset: 5801},
+			pos:  position{line: 515, col: 1, offset: 15492},
 			expr: &litMatcher{
-				pos:        position{line: 233, col: 14, offset: 5814},
+				pos:        position{line: 515, col: 14, offset: 15505},
 				val:        "null",
 				ignoreCase: false,
 				want:       "\"null\"",
@@ -1938,24 +4102,239 @@ var g = &grammar{
 			leader:        false,
 			leftRecursive: false,
 		},
+		{
+			name: "Recover",
+			pos:  position{line: 521, col: 1, offset: 15680},
+			expr: &actionExpr{
+				pos: position{line: 521, col: 12, offset: 15691},
+				run: (*parser).callonRecover1,
+				expr: &seqExpr{
+					pos: position{line: 521, col: 12, offset: 15691},
+					exprs: []any{
+						&andCodeExpr{
+							pos: position{line: 521, col: 12, offset: 15691},
+							run: (*parser).callonRecover3,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 521, col: 45, offset: 15724},
+							name: "Skipped",
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 521, col: 53, offset: 15732},
+							expr: &seqExpr{
+								pos: position{line: 521, col: 54, offset: 15733},
+								exprs: []any{
+									&notExpr{
+										pos: position{line: 521, col: 54, offset: 15733},
+										expr: &ruleRefExpr{
+											pos:  position{line: 521, col: 55, offset: 15734},
+											name: "Resync",
+										},
+									},
+									&ruleRefExpr{
+										pos:  position{line: 521, col: 62, offset: 15741},
+										name: "Skipped",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "Resync",
+			pos:  position{line: 527, col: 1, offset: 15899},
+			expr: &choiceExpr{
+				pos: position{line: 527, col: 11, offset: 15909},
+				alternatives: []any{
+					&seqExpr{
+						pos: position{line: 527, col: 11, offset: 15909},
+						exprs: []any{
+							&litMatcher{
+								pos:        position{line: 527, col: 11, offset: 15909},
+								val:        "\n",
+								ignoreCase: false,
+								want:       "\"\\n\"",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 527, col: 16, offset: 15914},
+								name: "_",
+							},
+							&choiceExpr{
+								pos: position{line: 527, col: 19, offset: 15917},
+								alternatives: []any{
+									&ruleRefExpr{
+										pos:  position{line: 527, col: 19, offset: 15917},
+										name: "PubToken",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 527, col: 30, offset: 15928},
+										name: "PvtToken",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 527, col: 41, offset: 15939},
+										name: "ClsToken",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 527, col: 52, offset: 15950},
+										name: "ImportToken",
+									},
+								},
+							},
+							&notExpr{
+								pos: position{line: 527, col: 65, offset: 15963},
+								expr: &charClassMatcher{
+									pos:        position{line: 527, col: 66, offset: 15964},
+									val:        "[a-zA-Z0-9_-]",
+									chars:      []rune{'_', '-'},
+									ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+									ignoreCase: false,
+									inverted:   false,
+								},
+							},
+						},
+					},
+					&litMatcher{
+						pos:        position{line: 527, col: 82, offset: 15980},
+						val:        "}",
+						ignoreCase: false,
+						want:       "\"}\"",
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "Skipped",
+			pos:  position{line: 531, col: 1, offset: 16136},
+			expr: &choiceExpr{
+				pos: position{line: 531, col: 12, offset: 16147},
+				alternatives: []any{
+					&seqExpr{
+						pos: position{line: 531, col: 12, offset: 16147},
+						exprs: []any{
+							&litMatcher{
+								pos:        position{line: 531, col: 12, offset: 16147},
+								val:        "\"",
+								ignoreCase: false,
+								want:       "\"\\\"\"",
+							},
+							&zeroOrMoreExpr{
+								pos: position{line: 531, col: 16, offset: 16151},
+								expr: &choiceExpr{
+									pos: position{line: 531, col: 17, offset: 16152},
+									alternatives: []any{
+										&seqExpr{
+											pos: position{line: 531, col: 17, offset: 16152},
+											exprs: []any{
+												&litMatcher{
+													pos:        position{line: 531, col: 17, offset: 16152},
+													val:        "\\",
+													ignoreCase: false,
+													want:       "\"\\\\\"",
+												},
+												&anyMatcher{
+													line: 531, col: 22, offset: 16157,
+												},
+											},
+										},
+										&charClassMatcher{
+											pos:        position{line: 531, col: 26, offset: 16161},
+											val:        "[^\"\\\\\\n]",
+											chars:      []rune{'"', '\\', '\n'},
+											ignoreCase: false,
+											inverted:   true,
+										},
+									},
+								},
+							},
+							&zeroOrOneExpr{
+								pos: position{line: 531, col: 37, offset: 16172},
+								expr: &litMatcher{
+									pos:        position{line: 531, col: 37, offset: 16172},
+									val:        "\"",
+									ignoreCase: false,
+									want:       "\"\\\"\"",
+								},
+							},
+						},
+					},
+					&ruleRefExpr{
+						pos:  position{line: 531, col: 44, offset: 16179},
+						name: "CommentToken",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 531, col: 59, offset: 16194},
+						name: "WordToken",
+					},
+					&seqExpr{
+						pos: position{line: 531, col: 71, offset: 16206},
+						exprs: []any{
+							&litMatcher{
+								pos:        position{line: 531, col: 71, offset: 16206},
+								val:        "{",
+								ignoreCase: false,
+								want:       "\"{\"",
+							},
+							&zeroOrMoreExpr{
+								pos: position{line: 531, col: 75, offset: 16210},
+								expr: &seqExpr{
+									pos: position{line: 531, col: 76, offset: 16211},
+									exprs: []any{
+										&notExpr{
+											pos: position{line: 531, col: 76, offset: 16211},
+											expr: &litMatcher{
+												pos:        position{line: 531, col: 77, offset: 16212},
+												val:        "}",
+												ignoreCase: false,
+												want:       "\"}\"",
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 531, col: 81, offset: 16216},
+											name: "Skipped",
+										},
+									},
+								},
+							},
+							&litMatcher{
+								pos:        position{line: 531, col: 91, offset: 16226},
+								val:        "}",
+								ignoreCase: false,
+								want:       "\"}\"",
+							},
+						},
+					},
+					&anyMatcher{
+						line: 531, col: 97, offset: 16232,
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
 		{
 			name:        "_",
 			displayName: "\"whitespace\"",
-			pos:         position{line: 235, col: 1, offset: 5822},
+			pos:         position{line: 533, col: 1, offset: 16235},
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 235, col: 19, offset: 5840},
+				pos: position{line: 533, col: 19, offset: 16253},
 				expr: &choiceExpr{
-					pos: position{line: 235, col: 20, offset: 5841},
+					pos: position{line: 533, col: 20, offset: 16254},
 					alternatives: []any{
 						&charClassMatcher{
-							pos:        position{line: 235, col: 20, offset: 5841},
+							pos:        position{line: 533, col: 20, offset: 16254},
 							val:        "[ \\t\\r\\n]",
 							chars:      []rune{' ', '\t', '\r', '\n'},
 							ignoreCase: false,
 							inverted:   false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 235, col: 32, offset: 5853},
+							pos:  position{line: 533, col: 32, offset: 16266},
 							name: "CommentToken",
 						},
 					},
@@ -1964,22 +4343,107 @@ var g = &grammar{
 			leader:        false,
 			leftRecursive: false,
 		},
+		{
+			name:        "__",
+			displayName: "\"whitespace\"",
+			pos:         position{line: 536, col: 1, offset: 16328},
+			expr: &zeroOrMoreExpr{
+				pos: position{line: 536, col: 20, offset: 16347},
+				expr: &choiceExpr{
+					pos: position{line: 536, col: 21, offset: 16348},
+					alternatives: []any{
+						&charClassMatcher{
+							pos:        position{line: 536, col: 21, offset: 16348},
+							val:        "[ \\t\\r]",
+							chars:      []rune{' ', '\t', '\r'},
+							ignoreCase: false,
+							inverted:   false,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 536, col: 31, offset: 16358},
+							name: "CommentToken",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "Terminator",
+			pos:  position{line: 538, col: 1, offset: 16374},
+			expr: &seqExpr{
+				pos: position{line: 538, col: 15, offset: 16388},
+				exprs: []any{
+					&ruleRefExpr{
+						pos:  position{line: 538, col: 15, offset: 16388},
+						name: "__",
+					},
+					&choiceExpr{
+						pos: position{line: 538, col: 19, offset: 16392},
+						alternatives: []any{
+							&ruleRefExpr{
+								pos:  position{line: 538, col: 19, offset: 16392},
+								name: "CommaToken",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 538, col: 32, offset: 16405},
+								name: "SemicolonToken",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 538, col: 49, offset: 16422},
+								name: "EolToken",
+							},
+							&andExpr{
+								pos: position{line: 538, col: 60, offset: 16433},
+								expr: &litMatcher{
+									pos:        position{line: 538, col: 61, offset: 16434},
+									val:        "}",
+									ignoreCase: false,
+									want:       "\"}\"",
+								},
+							},
+							&notExpr{
+								pos: position{line: 538, col: 67, offset: 16440},
+								expr: &anyMatcher{
+									line: 538, col: 68, offset: 16441,
+								},
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "EolToken",
+			pos:  position{line: 539, col: 1, offset: 16444},
+			expr: &litMatcher{
+				pos:        position{line: 539, col: 13, offset: 16456},
+				val:        "\n",
+				ignoreCase: false,
+				want:       "\"\\n\"",
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
 		{
 			name: "CommentToken",
-			pos:  position{line: 237, col: 1, offset: 5869},
+			pos:  position{line: 541, col: 1, offset: 16462},
 			expr: &seqExpr{
-				pos: position{line: 237, col: 17, offset: 5885},
+				pos: position{line: 541, col: 17, offset: 16478},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 237, col: 17, offset: 5885},
+						pos:        position{line: 541, col: 17, offset: 16478},
 						val:        "#",
 						ignoreCase: false,
 						want:       "\"#\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 237, col: 21, offset: 5889},
+						pos: position{line: 541, col: 21, offset: 16482},
 						expr: &charClassMatcher{
-							pos:        position{line: 237, col: 21, offset: 5889},
+							pos:        position{line: 541, col: 21, offset: 16482},
 							val:        "[^\\n]",
 							chars:      []rune{'\n'},
 							ignoreCase: false,
@@ -1994,6 +4458,16 @@ var g = &grammar{
 	},
 }
 
+func (c *current) onDash10(x any) (any, error) {
+	return x, nil
+}
+
+func (p *parser) callonDash10() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDash10(stack["x"])
+}
+
 func (c *current) onDash5(e any) (any, error) {
 	return e, nil
 }
@@ -2007,7 +4481,7 @@ func (p *parser) callonDash5() (any, error) {
 func (c *current) onDash1(es any) (any, error) {
 	exprs := sliceOf[Node](es)
 	log.Println("!!! DASH", exprs)
-	return Block{exprs}, nil
+	return Block{exprs, c.Loc()}, nil
 }
 
 func (p *parser) callonDash1() (any, error) {
@@ -2016,11 +4490,45 @@ func (p *parser) callonDash1() (any, error) {
 	return p.cur.onDash1(stack["es"])
 }
 
+func (c *current) onImport9(name any) (any, error) {
+	return name, nil
+}
+
+func (p *parser) callonImport9() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onImport9(stack["name"])
+}
+
+func (c *current) onImport1(path, alias any) (any, error) {
+	imp := Import{Loc: c.Loc()}
+	if alias != nil {
+		imp.Alias = alias.(string)
+	}
+	str, ok := path.(String)
+	if !ok {
+		return imp, &InferError{
+			Err: fmt.Errorf("import path cannot be interpolated"),
+			Loc: c.Loc(),
+		}
+	}
+	imp.Path = str.Value
+	return imp, nil
+}
+
+func (p *parser) callonImport1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onImport1(stack["path"], stack["alias"])
+}
+
 func (c *current) onClass1(name, block any) (any, error) {
 	return ClassDecl{
-		Named:      name.(string),
-		Value:      block.(Block),
-		Visibility: PrivateVisibility, // TODO
+		Named:       name.(string),
+		Value:       block.(Block),
+		Visibility:  PrivateVisibility, // TODO
+		Description: c.DocComment(),
+		Loc:         c.Loc(),
 	}, nil
 }
 
@@ -2032,269 +4540,514 @@ func (p *parser) callonClass1() (any, error) {
 
 func (c *current) onTypeAndValueSlot1(vis, name, type_, value any) (any, error) {
 	return SlotDecl{
-		Named:      name.(string),
-		Type_:      type_.(TypeNode),
-		Value:      value.(Node),
-		Visibility: vis.(Visibility),
+		Named:       name.(string),
+		Type_:       type_.(TypeNode),
+		Value:       value.(Node),
+		Visibility:  vis.(Visibility),
+		Description: c.DocComment(),
+		Loc:         c.Loc(),
+	}, nil
+}
+
+func (p *parser) callonTypeAndValueSlot1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onTypeAndValueSlot1(stack["vis"], stack["name"], stack["type_"], stack["value"])
+}
+
+func (c *current) onValueOnlySlot1(vis, name, val any) (any, error) {
+	return SlotDecl{
+		Named:       name.(string),
+		Value:       val.(Node),
+		Visibility:  vis.(Visibility),
+		Description: c.DocComment(),
+		Loc:         c.Loc(),
+	}, nil
+}
+
+func (p *parser) callonValueOnlySlot1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onValueOnlySlot1(stack["vis"], stack["name"], stack["val"])
+}
+
+func (c *current) onTypeOnlySlot1(vis, name, type_ any) (any, error) {
+	return SlotDecl{
+		Named:       name.(string),
+		Type_:       type_.(TypeNode),
+		Visibility:  vis.(Visibility),
+		Description: c.DocComment(),
+		Loc:         c.Loc(),
+	}, nil
+}
+
+func (p *parser) callonTypeOnlySlot1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onTypeOnlySlot1(stack["vis"], stack["name"], stack["type_"])
+}
+
+func (c *current) onTypeAndBlockSlot1(vis, name, type_, block any) (any, error) {
+	return SlotDecl{
+		Named: name.(string),
+		Type_: FunTypeNode{nil, type_.(TypeNode), c.Loc()},
+		Value: FunDecl{
+			Named:       name.(string),
+			Ret:         type_.(TypeNode),
+			Form:        block.(Block),
+			Description: c.DocComment(),
+			Loc:         c.Loc(),
+		},
+		Visibility:  vis.(Visibility),
+		Description: c.DocComment(),
+		Loc:         c.Loc(),
+	}, nil
+}
+
+func (p *parser) callonTypeAndBlockSlot1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onTypeAndBlockSlot1(stack["vis"], stack["name"], stack["type_"], stack["block"])
+}
+
+func (c *current) onTypeAndArgsAndBlockSlot1(vis, name, args, type_, block any) (any, error) {
+	return SlotDecl{
+		Named: name.(string),
+		Type_: FunTypeNode{args.([]SlotDecl), type_.(TypeNode), c.Loc()},
+		Value: FunDecl{
+			Named:       name.(string),
+			Args:        args.([]SlotDecl),
+			Ret:         type_.(TypeNode),
+			Form:        block.(Block),
+			Description: c.DocComment(),
+			Loc:         c.Loc(),
+		},
+		Visibility:  vis.(Visibility),
+		Description: c.DocComment(),
+		Loc:         c.Loc(),
+	}, nil
+}
+
+func (p *parser) callonTypeAndArgsAndBlockSlot1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onTypeAndArgsAndBlockSlot1(stack["vis"], stack["name"], stack["args"], stack["type_"], stack["block"])
+}
+
+func (c *current) onVisibility2() (any, error) {
+	return PublicVisibility, nil
+}
+
+func (p *parser) callonVisibility2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onVisibility2()
+}
+
+func (c *current) onVisibility4() (any, error) {
+	return PrivateVisibility, nil
+}
+
+func (p *parser) callonVisibility4() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onVisibility4()
+}
+
+func (c *current) onId5(name any) (bool, error) {
+	return !isKeyword(name.(string)), nil
+}
+
+func (p *parser) callonId5() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onId5(stack["name"])
+}
+
+func (c *current) onId1(name any) (any, error) {
+	return name, nil
+}
+
+func (p *parser) callonId1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onId1(stack["name"])
+}
+
+func (c *current) onWordToken1() (any, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonWordToken1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onWordToken1()
+}
+
+func (c *current) onUpperToken1() (any, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonUpperToken1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onUpperToken1()
+}
+
+func (c *current) onFunCall1(name, args any) (any, error) {
+	return FunCall{name.(Node), Record(args.([]Keyed[Node])), c.Loc()}, nil
+}
+
+func (p *parser) callonFunCall1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onFunCall1(stack["name"], stack["args"])
+}
+
+func (c *current) onArgValues1(args any) (any, error) {
+	return sliceOf[Keyed[Node]](args), nil
+}
+
+func (p *parser) callonArgValues1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onArgValues1(stack["args"])
+}
+
+func (c *current) onPositionalValue1(value any) (any, error) {
+	return Keyed[Node]{"", value.(Node)}, nil
+}
+
+func (p *parser) callonPositionalValue1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPositionalValue1(stack["value"])
+}
+
+func (c *current) onArgTypes1(args any) (any, error) {
+	return sliceOf[SlotDecl](args), nil
+}
+
+func (p *parser) callonArgTypes1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onArgTypes1(stack["args"])
+}
+
+func (c *current) onArgType1(slot any) (any, error) {
+	return slot.(SlotDecl), nil
+}
+
+func (p *parser) callonArgType1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onArgType1(stack["slot"])
+}
+
+func (c *current) onArgWithDefault1(name, type_, value any) (any, error) {
+	return SlotDecl{
+		Named:       name.(string),
+		Type_:       type_.(TypeNode),
+		Value:       value.(Node),
+		Description: c.DocComment(),
+		Loc:         c.Loc(),
+	}, nil
+}
+
+func (p *parser) callonArgWithDefault1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onArgWithDefault1(stack["name"], stack["type_"], stack["value"])
+}
+
+func (c *current) onArgWithBlockDefault1(name, type_, block any) (any, error) {
+	return SlotDecl{
+		Named:       name.(string),
+		Type_:       type_.(TypeNode),
+		Value:       block.(Block),
+		Description: c.DocComment(),
+		Loc:         c.Loc(),
+	}, nil
+}
+
+func (p *parser) callonArgWithBlockDefault1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onArgWithBlockDefault1(stack["name"], stack["type_"], stack["block"])
+}
+
+func (c *current) onArgWithType1(name, type_ any) (any, error) {
+	return SlotDecl{
+		Named:       name.(string),
+		Type_:       type_.(TypeNode),
+		Description: c.DocComment(),
+		Loc:         c.Loc(),
+	}, nil
+}
+
+func (p *parser) callonArgWithType1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onArgWithType1(stack["name"], stack["type_"])
+}
+
+func (c *current) onLambda2(args, type_, block any) (any, error) {
+	return FunDecl{
+		Args: args.([]SlotDecl),
+		Ret:  type_.(TypeNode),
+		Form: block.(Block),
+		Loc:  c.Loc(),
 	}, nil
 }
 
-func (p *parser) callonTypeAndValueSlot1() (any, error) {
+func (p *parser) callonLambda2() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onTypeAndValueSlot1(stack["vis"], stack["name"], stack["type_"], stack["value"])
+	return p.cur.onLambda2(stack["args"], stack["type_"], stack["block"])
 }
 
-func (c *current) onValueOnlySlot1(vis, name, val any) (any, error) {
-	return SlotDecl{
-		Named:      name.(string),
-		Value:      val.(Node),
-		Visibility: vis.(Visibility),
+func (c *current) onLambda16(args, block any) (any, error) {
+	return FunDecl{
+		Args: args.([]SlotDecl),
+		Form: block.(Block),
+		Loc:  c.Loc(),
 	}, nil
 }
 
-func (p *parser) callonValueOnlySlot1() (any, error) {
+func (p *parser) callonLambda16() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onValueOnlySlot1(stack["vis"], stack["name"], stack["val"])
+	return p.cur.onLambda16(stack["args"], stack["block"])
 }
 
-func (c *current) onTypeOnlySlot1(vis, name, type_ any) (any, error) {
-	return SlotDecl{
-		Named:      name.(string),
-		Type_:      type_.(TypeNode),
-		Visibility: vis.(Visibility),
+func (c *current) onLambda25(args, form any) (any, error) {
+	return FunDecl{
+		Args: args.([]SlotDecl),
+		Form: form.(Node),
+		Loc:  c.Loc(),
 	}, nil
 }
 
-func (p *parser) callonTypeOnlySlot1() (any, error) {
+func (p *parser) callonLambda25() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onTypeOnlySlot1(stack["vis"], stack["name"], stack["type_"])
+	return p.cur.onLambda25(stack["args"], stack["form"])
 }
 
-func (c *current) onTypeAndBlockSlot1(vis, name, type_, block any) (any, error) {
-	return SlotDecl{
-		Named: name.(string),
-		Type_: FunTypeNode{nil, type_.(TypeNode)},
-		Value: FunDecl{
-			Named: name.(string),
-			Ret:   type_.(TypeNode),
-			Form:  block.(Block),
-		},
-		Visibility: vis.(Visibility),
-	}, nil
+func (c *current) onLambdaArgs1(args any) (any, error) {
+	return sliceOf[SlotDecl](args), nil
 }
 
-func (p *parser) callonTypeAndBlockSlot1() (any, error) {
+func (p *parser) callonLambdaArgs1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onTypeAndBlockSlot1(stack["vis"], stack["name"], stack["type_"], stack["block"])
+	return p.cur.onLambdaArgs1(stack["args"])
 }
 
-func (c *current) onTypeAndArgsAndBlockSlot1(vis, name, args, type_, block any) (any, error) {
-	return SlotDecl{
-		Named: name.(string),
-		Type_: FunTypeNode{args.([]SlotDecl), type_.(TypeNode)},
-		Value: FunDecl{
-			Named: name.(string),
-			Args:  args.([]SlotDecl),
-			Ret:   type_.(TypeNode),
-			Form:  block.(Block),
-		},
-		Visibility: vis.(Visibility),
-	}, nil
+func (c *current) onLambdaArg1(slot any) (any, error) {
+	return slot.(SlotDecl), nil
 }
 
-func (p *parser) callonTypeAndArgsAndBlockSlot1() (any, error) {
+func (p *parser) callonLambdaArg1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onTypeAndArgsAndBlockSlot1(stack["vis"], stack["name"], stack["args"], stack["type_"], stack["block"])
+	return p.cur.onLambdaArg1(stack["slot"])
 }
 
-func (c *current) onVisibility2() (any, error) {
-	return PublicVisibility, nil
+func (c *current) onArgWithoutType1(name any) (any, error) {
+	return SlotDecl{
+		Named:       name.(string),
+		Description: c.DocComment(),
+		Loc:         c.Loc(),
+	}, nil
 }
 
-func (p *parser) callonVisibility2() (any, error) {
+func (p *parser) callonArgWithoutType1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onVisibility2()
+	return p.cur.onArgWithoutType1(stack["name"])
 }
 
-func (c *current) onVisibility4() (any, error) {
-	return PrivateVisibility, nil
+func (c *current) onKeyValue1(key, value any) (any, error) {
+	return Keyed[Node]{key.(string), value.(Node)}, nil
 }
 
-func (p *parser) callonVisibility4() (any, error) {
+func (p *parser) callonKeyValue1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onVisibility4()
+	return p.cur.onKeyValue1(stack["key"], stack["value"])
 }
 
-func (c *current) onWordToken1() (any, error) {
-	return string(c.text), nil
+func (c *current) onNamedType1(name any) (any, error) {
+	return NamedTypeNode{name.(string), c.Loc()}, nil
 }
 
-func (p *parser) callonWordToken1() (any, error) {
+func (p *parser) callonNamedType1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onWordToken1()
+	return p.cur.onNamedType1(stack["name"])
 }
 
-func (c *current) onUpperToken1() (any, error) {
-	return string(c.text), nil
+func (c *current) onListType1(inner any) (any, error) {
+	return ListTypeNode{inner.(TypeNode), c.Loc()}, nil
 }
 
-func (p *parser) callonUpperToken1() (any, error) {
+func (p *parser) callonListType1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onUpperToken1()
+	return p.cur.onListType1(stack["inner"])
 }
 
-func (c *current) onFunCall1(name, args any) (any, error) {
-	return FunCall{name.(Node), Record(args.([]Keyed[Node]))}, nil
+func (c *current) onNonNull1(inner any) (any, error) {
+	return NonNullTypeNode{inner.(TypeNode), c.Loc()}, nil
 }
 
-func (p *parser) callonFunCall1() (any, error) {
+func (p *parser) callonNonNull1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onFunCall1(stack["name"], stack["args"])
+	return p.cur.onNonNull1(stack["inner"])
 }
 
-func (c *current) onArgValues1(args any) (any, error) {
-	return sliceOf[Keyed[Node]](args), nil
+func (c *current) onFunType1(args, ret any) (any, error) {
+	return FunTypeNode{args.([]SlotDecl), ret.(TypeNode), c.Loc()}, nil
 }
 
-func (p *parser) callonArgValues1() (any, error) {
+func (p *parser) callonFunType1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onArgValues1(stack["args"])
+	return p.cur.onFunType1(stack["args"], stack["ret"])
 }
 
-func (c *current) onArgTypes1(args any) (any, error) {
-	return sliceOf[SlotDecl](args), nil
+func (c *current) onTypeVariable1() (any, error) {
+	return VariableTypeNode{c.text[0], c.Loc()}, nil
 }
 
-func (p *parser) callonArgTypes1() (any, error) {
+func (p *parser) callonTypeVariable1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onArgTypes1(stack["args"])
+	return p.cur.onTypeVariable1()
 }
 
-func (c *current) onArgType1(slot any) (any, error) {
-	return slot.(SlotDecl), nil
+func (c *current) onDefault2(left, right any) (any, error) {
+	return Default{left.(Node), right.(Node), c.Loc()}, nil
 }
 
-func (p *parser) callonArgType1() (any, error) {
+func (p *parser) callonDefault2() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onArgType1(stack["slot"])
+	return p.cur.onDefault2(stack["left"], stack["right"])
 }
 
-func (c *current) onArgWithDefault1(name, type_, value any) (any, error) {
-	return SlotDecl{
-		Named: name.(string),
-		Type_: type_.(TypeNode),
-		Value: value.(Node),
-	}, nil
+func (c *current) onOr2(left, op, right any) (any, error) {
+	return BinaryOp{string(op.([]byte)), left.(Node), right.(Node), c.Loc()}, nil
 }
 
-func (p *parser) callonArgWithDefault1() (any, error) {
+func (p *parser) callonOr2() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onArgWithDefault1(stack["name"], stack["type_"], stack["value"])
+	return p.cur.onOr2(stack["left"], stack["op"], stack["right"])
 }
 
-func (c *current) onArgWithBlockDefault1(name, type_, block any) (any, error) {
-	return SlotDecl{
-		Named: name.(string),
-		Type_: type_.(TypeNode),
-		Value: block.(Block),
-	}, nil
+func (c *current) onAnd2(left, op, right any) (any, error) {
+	return BinaryOp{string(op.([]byte)), left.(Node), right.(Node), c.Loc()}, nil
 }
 
-func (p *parser) callonArgWithBlockDefault1() (any, error) {
+func (p *parser) callonAnd2() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onArgWithBlockDefault1(stack["name"], stack["type_"], stack["block"])
+	return p.cur.onAnd2(stack["left"], stack["op"], stack["right"])
 }
 
-func (c *current) onArgWithType1(name, type_ any) (any, error) {
-	return SlotDecl{
-		Named: name.(string),
-		Type_: type_.(TypeNode),
-	}, nil
+func (c *current) onEquality2(left, op, right any) (any, error) {
+	return BinaryOp{string(op.([]byte)), left.(Node), right.(Node), c.Loc()}, nil
 }
 
-func (p *parser) callonArgWithType1() (any, error) {
+func (p *parser) callonEquality2() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onArgWithType1(stack["name"], stack["type_"])
+	return p.cur.onEquality2(stack["left"], stack["op"], stack["right"])
 }
 
-func (c *current) onKeyValue1(key, value any) (any, error) {
-	return Keyed[Node]{key.(string), value.(Node)}, nil
+func (c *current) onComparison2(left, op, right any) (any, error) {
+	return BinaryOp{string(op.([]byte)), left.(Node), right.(Node), c.Loc()}, nil
 }
 
-func (p *parser) callonKeyValue1() (any, error) {
+func (p *parser) callonComparison2() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onKeyValue1(stack["key"], stack["value"])
+	return p.cur.onComparison2(stack["left"], stack["op"], stack["right"])
 }
 
-func (c *current) onNamedType1(name any) (any, error) {
-	return NamedTypeNode{name.(string)}, nil
+func (c *current) onAdditive2(left, op, right any) (any, error) {
+	return BinaryOp{string(op.([]byte)), left.(Node), right.(Node), c.Loc()}, nil
 }
 
-func (p *parser) callonNamedType1() (any, error) {
+func (p *parser) callonAdditive2() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNamedType1(stack["name"])
+	return p.cur.onAdditive2(stack["left"], stack["op"], stack["right"])
 }
 
-func (c *current) onListType1(inner any) (any, error) {
-	return ListTypeNode{inner.(TypeNode)}, nil
+func (c *current) onMultiplicative2(left, op, right any) (any, error) {
+	return BinaryOp{string(op.([]byte)), left.(Node), right.(Node), c.Loc()}, nil
 }
 
-func (p *parser) callonListType1() (any, error) {
+func (p *parser) callonMultiplicative2() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onListType1(stack["inner"])
+	return p.cur.onMultiplicative2(stack["left"], stack["op"], stack["right"])
 }
 
-func (c *current) onNonNull1(inner any) (any, error) {
-	return NonNullTypeNode{inner.(TypeNode)}, nil
+func (c *current) onUnary2(op, operand any) (any, error) {
+	return UnaryOp{string(op.([]byte)), operand.(Node), c.Loc()}, nil
 }
 
-func (p *parser) callonNonNull1() (any, error) {
+func (p *parser) callonUnary2() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNonNull1(stack["inner"])
+	return p.cur.onUnary2(stack["op"], stack["operand"])
 }
 
-func (c *current) onTypeVariable1(v any) (any, error) {
-	return VariableTypeNode{v.(byte)}, nil
+func (c *current) onExec1(left, cmd any) (any, error) {
+	cmdStr := cmd.(string)
+	cmdOffset := len(c.text) - len(cmdStr) - 1
+	words, err := ShellWords(cmdStr)
+	if err != nil {
+		err = &InferError{
+			Err: fmt.Errorf("invalid command: %w", err),
+			Loc: c.LocAt(cmdOffset, len(cmdStr)),
+		}
+	}
+	args := make([]Node, len(words))
+	for i, word := range words {
+		args[i] = String{word.Value, c.LocAt(cmdOffset+word.Offset, word.Length)}
+	}
+	cmdLoc := c.LocAt(cmdOffset-1, len(cmdStr)+2)
+	return FunCall{
+		Fun:  Select{left.(Node), "with-exec", cmdLoc},
+		Args: Record{{"args", List{Elements: args, Loc: cmdLoc}}},
+		Loc:  c.Loc(),
+	}, err
 }
 
-func (p *parser) callonTypeVariable1() (any, error) {
+func (p *parser) callonExec1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onTypeVariable1(stack["v"])
+	return p.cur.onExec1(stack["left"], stack["cmd"])
 }
 
-func (c *current) onDefault1(left, right any) (any, error) {
-	return Default{left.(Node), right.(Node)}, nil
+func (c *current) onShellCommandToken1() (any, error) {
+	return string(c.text), nil
 }
 
-func (p *parser) callonDefault1() (any, error) {
+func (p *parser) callonShellCommandToken1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onDefault1(stack["left"], stack["right"])
+	return p.cur.onShellCommandToken1()
 }
 
 func (c *current) onSelect1(left, name any) (any, error) {
-	return Select{left.(Node), name.(string)}, nil
+	return Select{left.(Node), name.(string), c.TailLoc(len(name.(string)))}, nil
 }
 
 func (p *parser) callonSelect1() (any, error) {
@@ -2314,7 +5067,7 @@ func (p *parser) callonList7() (any, error) {
 }
 
 func (c *current) onList1(eles any) (any, error) {
-	return List{sliceOf[Node](eles)}, nil
+	return List{Elements: sliceOf[Node](eles), Loc: c.Loc()}, nil
 }
 
 func (p *parser) callonList1() (any, error) {
@@ -2323,6 +5076,46 @@ func (p *parser) callonList1() (any, error) {
 	return p.cur.onList1(stack["eles"])
 }
 
+func (c *current) onRecord7(kv any) (any, error) {
+	return kv, nil
+}
+
+func (p *parser) callonRecord7() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRecord7(stack["kv"])
+}
+
+func (c *current) onRecord1(fields any) (any, error) {
+	return Object{Fields: Record(sliceOf[Keyed[Node]](fields)), Loc: c.Loc()}, nil
+}
+
+func (p *parser) callonRecord1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRecord1(stack["fields"])
+}
+
+func (c *current) onBlock11(x any) (any, error) {
+	return x, nil
+}
+
+func (p *parser) callonBlock11() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onBlock11(stack["x"])
+}
+
+func (c *current) onBlock16(r any) (any, error) {
+	return r, nil
+}
+
+func (p *parser) callonBlock16() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onBlock16(stack["r"])
+}
+
 func (c *current) onBlock6(e any) (any, error) {
 	return e, nil
 }
@@ -2336,7 +5129,7 @@ func (p *parser) callonBlock6() (any, error) {
 func (c *current) onBlock1(es any) (any, error) {
 	exprs := sliceOf[Node](es)
 	log.Println("!!! BLOCK", exprs)
-	return Block{exprs}, nil
+	return Block{exprs, c.Loc()}, nil
 }
 
 func (p *parser) callonBlock1() (any, error) {
@@ -2345,8 +5138,92 @@ func (p *parser) callonBlock1() (any, error) {
 	return p.cur.onBlock1(stack["es"])
 }
 
+func (c *current) onParens1(e any) (any, error) {
+	return e, nil
+}
+
+func (p *parser) callonParens1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onParens1(stack["e"])
+}
+
+func (c *current) onConditional12(e any) (any, error) {
+	return e, nil
+}
+
+func (p *parser) callonConditional12() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onConditional12(stack["e"])
+}
+
+func (c *current) onConditional1(cond, then, else_ any) (any, error) {
+	var elseNode Node
+	if else_ != nil {
+		elseNode = else_.(Node)
+	}
+	return Conditional{cond.(Node), then.(Block), elseNode, c.Loc()}, nil
+}
+
+func (p *parser) callonConditional1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onConditional1(stack["cond"], stack["then"], stack["else_"])
+}
+
+func (c *current) onCase11(a any) (any, error) {
+	return a, nil
+}
+
+func (p *parser) callonCase11() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onCase11(stack["a"])
+}
+
+func (c *current) onCase1(subject, arms any) (any, error) {
+	return Case{subject.(Node), sliceOf[CaseArm](arms), c.Loc()}, nil
+}
+
+func (p *parser) callonCase1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onCase1(stack["subject"], stack["arms"])
+}
+
+func (c *current) onCaseArm2(body any) (any, error) {
+	return CaseArm{Else: true, Body: body.(Node), Loc: c.Loc()}, nil
+}
+
+func (p *parser) callonCaseArm2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onCaseArm2(stack["body"])
+}
+
+func (c *current) onCaseArm10(body any) (any, error) {
+	return CaseArm{Null: true, Body: body.(Node), Loc: c.Loc()}, nil
+}
+
+func (p *parser) callonCaseArm10() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onCaseArm10(stack["body"])
+}
+
+func (c *current) onCaseArm18(value, body any) (any, error) {
+	return CaseArm{Value: value.(string), Body: body.(Node), Loc: c.Loc()}, nil
+}
+
+func (p *parser) callonCaseArm18() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onCaseArm18(stack["value"], stack["body"])
+}
+
 func (c *current) onSymbol1(name any) (any, error) {
-	return Symbol{name.(string)}, nil
+	return Symbol{name.(string), c.Loc()}, nil
 }
 
 func (p *parser) callonSymbol1() (any, error) {
@@ -2355,39 +5232,91 @@ func (p *parser) callonSymbol1() (any, error) {
 	return p.cur.onSymbol1(stack["name"])
 }
 
-func (c *current) onInt3() (any, error) {
+func (c *current) onInt1() (any, error) {
 	value, err := strconv.ParseInt(string(c.text), 10, 64)
 	if err != nil {
-		return nil, err
+		// return the node anyway so the parser can carry on
+		return Int{value, c.Loc()}, &InferError{
+			Err: fmt.Errorf("%s is out of range for Int", c.text),
+			Loc: c.Loc(),
+		}
 	}
-	return Int(value), nil
+	return Int{value, c.Loc()}, nil
 }
 
-func (p *parser) callonInt3() (any, error) {
+func (p *parser) callonInt1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onInt3()
+	return p.cur.onInt1()
 }
 
-func (c *current) onString1() (any, error) {
-	c.text = bytes.Replace(c.text, []byte(`\/`), []byte(`/`), -1)
-	value, err := strconv.Unquote(string(c.text))
+func (c *current) onFloat1() (any, error) {
+	value, err := strconv.ParseFloat(string(c.text), 64)
 	if err != nil {
-		return nil, err
+		return Float{value, c.Loc()}, &InferError{
+			Err: fmt.Errorf("%s is out of range for Float", c.text),
+			Loc: c.Loc(),
+		}
+	}
+	return Float{value, c.Loc()}, nil
+}
+
+func (p *parser) callonFloat1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onFloat1()
+}
+
+func (c *current) onString1(parts any) (any, error) {
+	nodes := sliceOf[Node](parts)
+	switch {
+	case len(nodes) == 0:
+		return String{"", c.Loc()}, nil
+	case len(nodes) == 1:
+		if str, ok := nodes[0].(String); ok {
+			return String{str.Value, c.Loc()}, nil
+		}
 	}
-	return String{value}, nil
+	return Interpolation{nodes, c.Loc()}, nil
 }
 
 func (p *parser) callonString1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onString1()
+	return p.cur.onString1(stack["parts"])
+}
+
+func (c *current) onStringInterpolation1(e any) (any, error) {
+	return e, nil
+}
+
+func (p *parser) callonStringInterpolation1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onStringInterpolation1(stack["e"])
+}
+
+func (c *current) onStringCharsToken1() (any, error) {
+	text := bytes.Replace(c.text, []byte(`\/`), []byte(`/`), -1)
+	text = bytes.Replace(text, []byte(`\$`), []byte(`$`), -1)
+	value, err := strconv.Unquote(`"` + string(text) + `"`)
+	if err != nil {
+		return nil, err
+	}
+	return String{value, c.Loc()}, nil
+}
+
+func (p *parser) callonStringCharsToken1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onStringCharsToken1()
 }
 
 func (c *current) onQuoted1(quoter, raw any) (any, error) {
 	return Quoted{
 		quoter.(string),
 		raw.(string),
+		c.Loc(),
 	}, nil
 }
 
@@ -2397,18 +5326,68 @@ func (p *parser) callonQuoted1() (any, error) {
 	return p.cur.onQuoted1(stack["quoter"], stack["raw"])
 }
 
-func (c *current) onQuotedRawToken1() (any, error) {
+func (c *current) onQuotedRaw1() (any, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonQuotedRaw1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onQuotedRaw1()
+}
+
+func (c *current) onPath1(path any) (any, error) {
+	if strings.HasSuffix(path.(string), "/") {
+		return DirPath{path.(string), c.Loc()}, nil
+	}
+	return FilePath{path.(string), c.Loc()}, nil
+}
+
+func (p *parser) callonPath1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPath1(stack["path"])
+}
+
+func (c *current) onPathToken1() (any, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonPathToken1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPathToken1()
+}
+
+func (c *current) onRelativePathToken1() (any, error) {
 	return string(c.text), nil
 }
 
-func (p *parser) callonQuotedRawToken1() (any, error) {
+func (p *parser) callonRelativePathToken1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRelativePathToken1()
+}
+
+func (c *current) onJoin1(dir, path any) (any, error) {
+	var sub Node
+	loc := c.TailLoc(len(path.(string)))
+	if strings.HasSuffix(path.(string), "/") {
+		sub = DirPath{path.(string), loc}
+	} else {
+		sub = FilePath{path.(string), loc}
+	}
+	return Join{dir.(Node), sub, c.Loc()}, nil
+}
+
+func (p *parser) callonJoin1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onQuotedRawToken1()
+	return p.cur.onJoin1(stack["dir"], stack["path"])
 }
 
 func (c *current) onBoolean2() (any, error) {
-	return Boolean(true), nil
+	return Boolean{true, c.Loc()}, nil
 }
 
 func (p *parser) callonBoolean2() (any, error) {
@@ -2418,7 +5397,7 @@ func (p *parser) callonBoolean2() (any, error) {
 }
 
 func (c *current) onBoolean4() (any, error) {
-	return Boolean(false), nil
+	return Boolean{false, c.Loc()}, nil
 }
 
 func (p *parser) callonBoolean4() (any, error) {
@@ -2428,7 +5407,7 @@ func (p *parser) callonBoolean4() (any, error) {
 }
 
 func (c *current) onNull1() (any, error) {
-	return Null{}, nil
+	return Null{c.Loc()}, nil
 }
 
 func (p *parser) callonNull1() (any, error) {
@@ -2437,6 +5416,26 @@ func (p *parser) callonNull1() (any, error) {
 	return p.cur.onNull1()
 }
 
+func (c *current) onRecover3() (bool, error) {
+	return c.MarkFailure(), nil
+}
+
+func (p *parser) callonRecover3() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRecover3()
+}
+
+func (c *current) onRecover1() (any, error) {
+	return nil, c.SyntaxError()
+}
+
+func (p *parser) callonRecover1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRecover1()
+}
+
 var (
 	// errNoRule is returned when the grammar to parse has no rule.
 	errNoRule = errors.New("grammar has no rule")
@@ -2594,7 +5593,7 @@ func InitState(key string, value any) Option {
 }
 
 // ParseFile parses the file identified by filename.
-func ParseFile(filename string, opts ...Option) (i any, err error) {
+func parseFile(filename string, opts ...Option) (i any, err error) {
 	f, err := os.Open(filename)
 	if err != nil {
 		return nil, err
@@ -2604,23 +5603,23 @@ func ParseFile(filename string, opts ...Option) (i any, err error) {
 			err = closeErr
 		}
 	}()
-	return ParseReader(filename, f, opts...)
+	return parseReader(filename, f, opts...)
 }
 
 // ParseReader parses the data from r using filename as information in the
 // error messages.
-func ParseReader(filename string, r io.Reader, opts ...Option) (any, error) {
+func parseReader(filename string, r io.Reader, opts ...Option) (any, error) {
 	b, err := io.ReadAll(r)
 	if err != nil {
 		return nil, err
 	}
 
-	return Parse(filename, b, opts...)
+	return parse(filename, b, opts...)
 }
 
 // Parse parses the data from b using filename as information in the
 // error messages.
-func Parse(filename string, b []byte, opts ...Option) (any, error) {
+func parse(filename string, b []byte, opts ...Option) (any, error) {
 	return newParser(filename, b, opts...).parse(g)
 }
 
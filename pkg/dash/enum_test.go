@@ -0,0 +1,68 @@
+package dash
+
+import "testing"
+
+func TestEnums(t *testing.T) {
+	runCheckCases(t, []checkCase{
+		{
+			Name: "bare value argument",
+			Src:  `pub c = container.with-exposed-port(port: 53, protocol: UDP)`,
+		},
+		{
+			Name: "qualified value argument",
+			Src:  `pub c = container.with-exposed-port(port: 53, protocol: NetworkProtocol.UDP)`,
+		},
+		{
+			Name: "bare value slot",
+			Src:  `pub p: NetworkProtocol! = TCP`,
+		},
+		{
+			Name: "qualified value slot",
+			Src: `pub p = NetworkProtocol.TCP
+pub c = container.with-exposed-port(port: 80, protocol: p)`,
+		},
+		{
+			Name: "bare value in a list",
+			Src:  `pub ps: [NetworkProtocol!]! = [TCP, UDP]`,
+		},
+		{
+			Name: "invalid bare value",
+			Src:  `pub c = container.with-exposed-port(port: 53, protocol: SCTP)`,
+			Err:  "SCTP is not a value of NetworkProtocol; expected TCP or UDP",
+		},
+		{
+			Name: "invalid qualified value",
+			Src:  `pub p = NetworkProtocol.SCTP`,
+			Err:  `field "SCTP" not found`,
+		},
+		{
+			Name: "bare value without an expected type",
+			Src:  `pub p = TCP`,
+			Err:  `"TCP" not found`,
+		},
+		{
+			Name: "string where an enum is expected",
+			Src:  `pub c = container.with-exposed-port(port: 53, protocol: "UDP")`,
+			Err:  "NetworkProtocol ~ String",
+		},
+		{
+			Name: "enum where a string is expected",
+			Src:  `pub c = container.from(address: NetworkProtocol.TCP)`,
+			Err:  "String ~ NetworkProtocol",
+		},
+		{
+			Name: "bound name shadows a value",
+			Src: `pub UDP = "udp"
+pub c = container.with-exposed-port(port: 53, protocol: UDP)`,
+			Err: "NetworkProtocol ~ String",
+		},
+		{
+			Name: "defaulted enum argument",
+			Src:  `pub c = container.with-exposed-port(port: 53)`,
+		},
+		{
+			Name: "values are equatable",
+			Src:  `pub b: Boolean! = NetworkProtocol.TCP == NetworkProtocol.UDP`,
+		},
+	})
+}
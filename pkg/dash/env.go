@@ -12,17 +12,38 @@ import (
 type Module struct {
 	Named string
 
+	// Description is the documentation for the class or schema type.
+	Description string
+
 	Parent *Module
 
-	classes map[string]*Module
-	vars    map[string]*hm.Scheme
+	classes   map[string]*Module
+	records   map[string]*RecordType
+	vars      map[string]*hm.Scheme
+	docs      map[string]string
+	gqlNames  map[string]string
+	operators map[string][]*hm.Scheme
+
+	// enumValues is set for enum types
+	enumValues []string
+
+	// required is set for classes, naming the slots that must be passed to the
+	// constructor, in the order they are declared
+	required []string
+
+	// importer is set for file modules
+	importer Importer
 }
 
 func NewModule(name string) *Module {
 	env := &Module{
-		Named:   name,
-		classes: make(map[string]*Module),
-		vars:    make(map[string]*hm.Scheme),
+		Named:     name,
+		classes:   make(map[string]*Module),
+		records:   make(map[string]*RecordType),
+		vars:      make(map[string]*hm.Scheme),
+		docs:      make(map[string]string),
+		gqlNames:  make(map[string]string),
+		operators: make(map[string][]*hm.Scheme),
 	}
 	return env
 }
@@ -52,7 +73,7 @@ func gqlToTypeNode(mod *Module, ref *introspection.TypeRef) (hm.Type, error) {
 		}
 		return t, nil
 	case introspection.TypeKindInputObject:
-		t, found := mod.NamedType(ref.Name)
+		t, found := mod.NamedRecord(ref.Name)
 		if !found {
 			return nil, fmt.Errorf("gqlToTypeNode: %q not found", ref.Name)
 		}
@@ -77,12 +98,26 @@ func gqlToTypeNode(mod *Module, ref *introspection.TypeRef) (hm.Type, error) {
 func NewEnv(schema *introspection.Schema) *Module {
 	mod := NewModule("<dash>")
 
+	// built-in scalars are available even if the schema doesn't use them
+	for _, scalar := range []string{"Boolean", "String", "Int", "Float"} {
+		mod.AddClass(NewModule(scalar))
+	}
+
+	// the type of directory path literals, which may be passed as strings
+	mod.AddClass(NewModule("DirPath"))
+
 	for _, t := range schema.Types {
+		if t.Kind == introspection.TypeKindInputObject {
+			// input objects are records, installed below once all types are known
+			mod.AddRecord(NewRecordType(t.Name))
+			continue
+		}
 		sub, found := mod.NamedType(t.Name)
 		if !found {
 			sub = NewModule(t.Name)
 			mod.AddClass(sub)
 		}
+		sub.Description = t.Description
 		if t.Name == schema.QueryType.Name {
 			// Set Query as the parent of the outermost module so that its fields are
 			// defined globally.
@@ -91,17 +126,20 @@ func NewEnv(schema *introspection.Schema) *Module {
 	}
 
 	for _, t := range schema.Types {
+		if t.Kind == introspection.TypeKindInputObject {
+			installInputObject(mod, t)
+			continue
+		}
+
 		install, found := mod.NamedType(t.Name)
 		if !found {
 			// we just set it above...
 			panic(fmt.Errorf("NewEnv: impossible: %q not found", t.Name))
 		}
 
-		// TODO assign input fields, maybe input classes are "just" records?
-		//t.InputFields
-
-		// TODO assign enum constructors
-		//t.EnumValues
+		if t.Kind == introspection.TypeKindEnum {
+			installEnum(mod, install, t)
+		}
 
 		for _, f := range t.Fields {
 			ret, err := gqlToTypeNode(mod, f.TypeRef)
@@ -109,43 +147,158 @@ func NewEnv(schema *introspection.Schema) *Module {
 				panic(err)
 			}
 
+			// fields and args are named in kebab-case, e.g. with-exec
+			name := KebabCase(f.Name)
+			install.AddGraphQLName(name, f.Name)
+
+			if f.Description != "" {
+				install.AddDoc(name, f.Description)
+			}
+
 			if len(f.Args) > 0 {
 				args := NewRecordType("")
+				args.Defaults = Set[string]{}
+				args.Docs = map[string]string{}
+				args.GraphQLNames = map[string]string{}
 				for _, arg := range f.Args {
 					argType, err := gqlToTypeNode(mod, arg.TypeRef)
 					if err != nil {
 						panic(err)
 					}
-					args.Add(arg.Name, hm.NewScheme(nil, argType))
+					argName := KebabCase(arg.Name)
+					args.Add(argName, hm.NewScheme(nil, argType))
+					args.GraphQLNames[argName] = arg.Name
+					if arg.DefaultValue != nil {
+						// may be omitted even if it's non-null
+						args.Defaults[argName] = struct{}{}
+					}
+					if arg.Description != "" {
+						args.Docs[argName] = arg.Description
+					}
 				}
 				log.Println("ADDING FUN", t.Name, f.Name)
-				install.Add(f.Name, hm.NewScheme(nil, hm.NewFnType(args, ret)))
+				install.Add(name, hm.NewScheme(nil, hm.NewFnType(args, ret)))
 			} else {
 				log.Println("ADDING 0-ARITY FIELD", t.Name, f.Name)
-				install.Add(f.Name, hm.NewScheme(nil, ret))
+				install.Add(name, hm.NewScheme(nil, ret))
 			}
 		}
+
+		if t.Kind == introspection.TypeKindObject && t.Name != schema.QueryType.Name {
+			installWith(install)
+		}
+	}
+
+	if err := installOperators(mod); err != nil {
+		panic(err)
 	}
 
 	return mod
 }
 
+// installInputObject adds the fields of an input object type to its record
+// type. Like arguments, fields that are nullable or have a default may be
+// omitted.
+func installInputObject(mod *Module, t *introspection.Type) {
+	rt, found := mod.NamedRecord(t.Name)
+	if !found {
+		panic(fmt.Errorf("installInputObject: impossible: %q not found", t.Name))
+	}
+	rt.Defaults = Set[string]{}
+	rt.Docs = map[string]string{}
+	rt.GraphQLNames = map[string]string{}
+	for _, f := range t.InputFields {
+		ft, err := gqlToTypeNode(mod, f.TypeRef)
+		if err != nil {
+			panic(err)
+		}
+		name := KebabCase(f.Name)
+		rt.Add(name, hm.NewScheme(nil, ft))
+		rt.GraphQLNames[name] = f.Name
+		if f.DefaultValue != nil {
+			rt.Defaults[name] = struct{}{}
+		}
+		if f.Description != "" {
+			rt.Docs[name] = f.Description
+		}
+	}
+}
+
+// installEnum adds the values of an enum type, and binds a namespace of them
+// named after the type, so they can be referred to like NetworkProtocol.TCP.
+func installEnum(mod, enum *Module, t *introspection.Type) {
+	values := NewModule(t.Name)
+	for _, v := range t.EnumValues {
+		enum.AddEnumValue(v.Name)
+		values.Add(v.Name, hm.NewScheme(nil, NonNullType{enum}))
+		if v.Description != "" {
+			values.AddDoc(v.Name, v.Description)
+		}
+	}
+	mod.Add(t.Name, hm.NewScheme(nil, NonNullType{values}))
+	if t.Description != "" {
+		mod.AddDoc(t.Name, t.Description)
+	}
+}
+
+// installWith adds a with method to an object type, which passes the object
+// to a function returning another one of the same type. This allows reusable
+// steps to be applied in the middle of a chain, like ctr.with(goCache).
+func installWith(class *Module) {
+	if _, found := class.LocalSchemeOf("with"); found {
+		// the schema defines its own
+		return
+	}
+	self := NonNullType{class}
+	fn := hm.NewFnType(NewRecordType("", Keyed[*hm.Scheme]{"self", hm.NewScheme(nil, self)}), self)
+	args := NewRecordType("", Keyed[*hm.Scheme]{"f", hm.NewScheme(nil, fn)})
+	class.Add("with", hm.NewScheme(nil, hm.NewFnType(args, self)))
+}
+
 var _ hm.Substitutable = (*Module)(nil)
 
 func (e *Module) Apply(subs hm.Subs) hm.Substitutable {
-	retVal := e.Clone().(*Module)
-	for _, v := range retVal.vars {
-		v.Apply(subs)
-	}
-	return retVal
+	// modules are compared by identity, so substituting must not copy them, and
+	// a clone has no vars of its own to substitute anyway
+	return e
 }
 
 func (e *Module) FreeTypeVar() hm.TypeVarSet {
-	var retVal hm.TypeVarSet
-	for _, v := range e.vars {
-		retVal = v.FreeTypeVar().Union(retVal)
+	// modules are nominal types; collecting the free vars of their slots would
+	// loop forever on self-referential classes like Container
+	return nil
+}
+
+// applySubs substitutes the type variables solved during inference in the
+// types of the module's slots and those of its parents.
+func (e *Module) applySubs(subs hm.Subs) {
+	if subs == nil {
+		return
+	}
+	for m := e; m != nil; m = m.Parent {
+		for name, s := range m.vars {
+			if len(s.FreeTypeVar()) == 0 {
+				continue
+			}
+			t, _ := s.Type()
+			bound := t.FreeTypeVar().Difference(s.FreeTypeVar())
+			// the scheme leaves its bound variables alone
+			m.vars[name] = hm.NewScheme(bound, cloneType(t)).Apply(subs).(*hm.Scheme)
+		}
+	}
+}
+
+// freeTypeVars returns the type variables in the types of the module's slots
+// and those of its parents that are not yet known, which must not be
+// generalized.
+func (e *Module) freeTypeVars() hm.TypeVarSet {
+	var tvs hm.TypeVarSet
+	for m := e; m != nil; m = m.Parent {
+		for _, s := range m.vars {
+			tvs = s.FreeTypeVar().Union(tvs)
+		}
 	}
-	return retVal
+	return tvs
 }
 
 func (e *Module) Add(name string, s *hm.Scheme) hm.Env {
@@ -164,6 +317,74 @@ func (e *Module) SchemeOf(name string) (*hm.Scheme, bool) {
 	return nil, false
 }
 
+// AddDoc sets the description of a slot.
+func (e *Module) AddDoc(name, doc string) *Module {
+	e.docs[name] = doc
+	return e
+}
+
+// DocOf returns the description of a slot.
+func (e *Module) DocOf(name string) (string, bool) {
+	doc, ok := e.docs[name]
+	if ok {
+		return doc, ok
+	}
+	if e.Parent != nil {
+		return e.Parent.DocOf(name)
+	}
+	return "", false
+}
+
+// AddRequired marks a slot as required by the class's constructor.
+func (e *Module) AddRequired(name string) *Module {
+	for _, r := range e.required {
+		if r == name {
+			return e
+		}
+	}
+	e.required = append(e.required, name)
+	return e
+}
+
+// Required returns the slots that must be passed to the class's constructor.
+func (e *Module) Required() []string {
+	return e.required
+}
+
+// AddGraphQLName records the name in the schema of a slot installed from it.
+func (e *Module) AddGraphQLName(name, gqlName string) *Module {
+	e.gqlNames[name] = gqlName
+	return e
+}
+
+// GraphQLName returns the name of a slot in the schema. Slots that weren't
+// installed from the schema are converted with CamelCase.
+func (e *Module) GraphQLName(name string) string {
+	if gqlName, ok := e.gqlNames[name]; ok {
+		return gqlName
+	}
+	return CamelCase(name)
+}
+
+// IsField returns whether the slot was installed from a field of the schema,
+// as opposed to being declared in dash.
+func (e *Module) IsField(name string) bool {
+	if _, ok := e.vars[name]; ok {
+		_, ok := e.gqlNames[name]
+		return ok
+	}
+	if e.Parent != nil {
+		return e.Parent.IsField(name)
+	}
+	return false
+}
+
+// LocalSchemeOf is like SchemeOf, but does not consult the parent module.
+func (e *Module) LocalSchemeOf(name string) (*hm.Scheme, bool) {
+	s, ok := e.vars[name]
+	return s, ok
+}
+
 func (e *Module) Clone() hm.Env {
 	mod := NewModule(e.Named)
 	mod.Parent = e
@@ -175,6 +396,48 @@ func (e *Module) AddClass(c *Module) *Module {
 	return e
 }
 
+// Importer returns the importer for the file the module belongs to.
+func (e *Module) Importer() (Importer, bool) {
+	if e.importer != nil {
+		return e.importer, true
+	}
+	if e.Parent != nil {
+		return e.Parent.Importer()
+	}
+	return nil, false
+}
+
+// AddEnumValue adds a possible value to an enum type.
+func (e *Module) AddEnumValue(name string) *Module {
+	e.enumValues = append(e.enumValues, name)
+	return e
+}
+
+// EnumValues returns the possible values of an enum type, or nil if the module
+// is not an enum.
+func (e *Module) EnumValues() []string {
+	return e.enumValues
+}
+
+// AddRecord adds a named record type, i.e. an input object.
+func (e *Module) AddRecord(r *RecordType) *Module {
+	e.records[r.Named] = r
+	return e
+}
+
+// NamedRecord returns the named record type, i.e. input object, with the
+// given name.
+func (e *Module) NamedRecord(name string) (*RecordType, bool) {
+	r, ok := e.records[name]
+	if ok {
+		return r, ok
+	}
+	if e.Parent != nil {
+		return e.Parent.NamedRecord(name)
+	}
+	return nil, false
+}
+
 func (e *Module) NamedType(name string) (*Module, bool) {
 	t, ok := e.classes[name]
 	if ok {
@@ -0,0 +1,243 @@
+package dash
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/chewxy/hm"
+)
+
+// SourceLocation is a span of source text that a node was parsed from.
+type SourceLocation struct {
+	Filename string
+	Line     int // 1-based
+	Column   int // 1-based, in runes
+	Offset   int // 0-based, in bytes
+	Length   int // in bytes
+}
+
+func (l *SourceLocation) String() string {
+	if l == nil {
+		return "<unknown>"
+	}
+	return fmt.Sprintf("%s:%d:%d", l.Filename, l.Line, l.Column)
+}
+
+// Located is implemented by anything parsed from source, i.e. nodes and type
+// nodes.
+type Located interface {
+	GetSourceLocation() *SourceLocation
+}
+
+// InferError is an error annotated with the location of the node that caused
+// it.
+type InferError struct {
+	Err error
+	Loc *SourceLocation
+
+	// Source is the content of the file the error originated from, used to
+	// render an excerpt. It is set by CheckFile once the error is returned.
+	Source []byte
+
+	// Doc is the description of what was expected, e.g. of the argument that
+	// was given the wrong type, shown after the excerpt.
+	Doc string
+}
+
+// withDoc attaches the description of a slot or argument to err, if it has
+// one and err doesn't already have one from a more specific node.
+func withDoc(err error, name, doc string) error {
+	var ie *InferError
+	if doc != "" && errors.As(err, &ie) && ie.Doc == "" {
+		ie.Doc = name + ": " + doc
+	}
+	return err
+}
+
+// NewInferError annotates err with the location of the given node, unless it
+// already carries a location from a more specific node.
+func NewInferError(err error, node Located) error {
+	var ie *InferError
+	if errors.As(err, &ie) {
+		return err
+	}
+	return &InferError{
+		Err: err,
+		Loc: node.GetSourceLocation(),
+	}
+}
+
+// WithInferErrorHandling calls fn, annotating any error it returns with the
+// location of the given node.
+func WithInferErrorHandling(node Located, fn func() (hm.Type, error)) (hm.Type, error) {
+	t, err := fn()
+	if err != nil {
+		return nil, NewInferError(err, node)
+	}
+	return t, nil
+}
+
+func (e *InferError) Unwrap() error {
+	return e.Err
+}
+
+func (e *InferError) Error() string {
+	if e.Loc == nil {
+		return e.Err.Error()
+	}
+	msg := fmt.Sprintf("%s: %s", e.Loc, e.Err)
+	if excerpt := e.Excerpt(); excerpt != "" {
+		msg += "\n" + excerpt
+	}
+	if e.Doc != "" {
+		msg += "\n\n" + indent(e.Doc, "  ")
+	}
+	return msg
+}
+
+// Excerpt renders the source line the error points to with the offending span
+// underlined, or an empty string if the source is not known.
+func (e *InferError) Excerpt() string {
+	if e.Loc == nil || e.Source == nil {
+		return ""
+	}
+
+	lines := strings.Split(string(e.Source), "\n")
+	if e.Loc.Line < 1 || e.Loc.Line > len(lines) {
+		return ""
+	}
+	line := strings.TrimRight(lines[e.Loc.Line-1], "\r")
+
+	// underline up to the end of the line; multi-line spans only underline
+	// their first line
+	runes := []rune(line)
+	col := e.Loc.Column - 1
+	if col < 0 {
+		col = 0
+	} else if col > len(runes) {
+		col = len(runes)
+	}
+	width := len(runes) - col
+	end := e.Loc.Offset + e.Loc.Length
+	if end > len(e.Source) {
+		end = len(e.Source)
+	}
+	if span := utf8.RuneCount(e.Source[e.Loc.Offset:end]); span < width {
+		width = span
+	}
+	if width < 1 {
+		width = 1
+	}
+
+	// preserve tabs so the caret lines up
+	var indent strings.Builder
+	for _, r := range runes[:col] {
+		if r == '\t' {
+			indent.WriteRune('\t')
+		} else {
+			indent.WriteRune(' ')
+		}
+	}
+
+	gutter := fmt.Sprintf("%d", e.Loc.Line)
+	pad := strings.Repeat(" ", len(gutter))
+
+	out := new(strings.Builder)
+	fmt.Fprintf(out, "%s |\n", pad)
+	fmt.Fprintf(out, "%s | %s\n", gutter, line)
+	fmt.Fprintf(out, "%s | %s%s", pad, indent.String(), strings.Repeat("^", width))
+	return out.String()
+}
+
+// Diagnostics is the list of errors found while checking a program.
+type Diagnostics []*InferError
+
+func (d Diagnostics) Unwrap() []error {
+	errs := make([]error, len(d))
+	for i, e := range d {
+		errs[i] = e
+	}
+	return errs
+}
+
+func (d Diagnostics) Error() string {
+	msgs := make([]string, len(d))
+	for i, e := range d {
+		msgs[i] = e.Error()
+	}
+	return strings.Join(msgs, "\n\n")
+}
+
+// Diagnose flattens err into the located errors it is composed of, dropping
+// duplicates and errors that were only caused by earlier ones.
+func Diagnose(err error) Diagnostics {
+	var diags Diagnostics
+	seen := map[string]bool{}
+	for _, ie := range diagnose(err) {
+		key := ie.Loc.String() + ": " + ie.Err.Error()
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		diags = append(diags, ie)
+	}
+	return diags
+}
+
+func diagnose(err error) []*InferError {
+	switch x := err.(type) {
+	case nil:
+		return nil
+	case *InferError:
+		if errors.Is(x.Err, errSuppressed) {
+			return nil
+		}
+		return []*InferError{x}
+	case interface{ Unwrap() []error }:
+		var ies []*InferError
+		for _, e := range x.Unwrap() {
+			ies = append(ies, diagnose(e)...)
+		}
+		return ies
+	}
+	var ie *InferError
+	if errors.As(err, &ie) {
+		// discard the context leading up to the located error(s)
+		return diagnose(errors.Unwrap(err))
+	}
+	if errors.Is(err, errSuppressed) {
+		return nil
+	}
+	return []*InferError{{Err: err}}
+}
+
+// errSuppressed is returned when inferring a reference to something that has
+// already failed to check. It is never reported.
+var errSuppressed = errors.New("suppressed")
+
+// errorType is bound to slots that failed to check.
+type errorType struct{}
+
+var _ hm.Type = errorType{}
+
+func (errorType) Name() string                                 { return "<error>" }
+func (t errorType) Apply(hm.Subs) hm.Substitutable             { return t }
+func (errorType) FreeTypeVar() hm.TypeVarSet                   { return nil }
+func (t errorType) Normalize(k, v hm.TypeVarSet) (Type, error) { return t, nil }
+func (errorType) Types() hm.Types                              { return nil }
+func (errorType) Eq(other Type) bool                           { _, ok := other.(errorType); return ok }
+func (errorType) String() string                               { return "<error>" }
+func (errorType) Format(s fmt.State, c rune)                   { fmt.Fprint(s, "<error>") }
+
+// indent prefixes each non-empty line of s.
+func indent(s, prefix string) string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		if line != "" {
+			lines[i] = prefix + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
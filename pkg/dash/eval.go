@@ -1,31 +1,16 @@
 package dash
 
 import (
-	"log"
-
 	"github.com/dagger/dagger/codegen/introspection"
 )
 
 func CheckFile(schema *introspection.Schema, filePath string) error {
-	dash, err := ParseFile(filePath)
-	if err != nil {
-		return err
-	}
+	loader := NewLoader(schema)
 
 	// DISCLAIMER: i dont know wtf im doing, I'll go read a book sometime
 
-	node := dash.(Block)
-
-	env := NewEnv(schema)
-
-	inferred, err := Infer(env, node, true)
-	if err != nil {
-		return err
-	}
-
-	log.Printf("INFERRED END: %T", inferred)
-
-	return nil
+	_, err := loader.Import(filePath)
+	return loader.Diagnose(err)
 	// return EvalReader(ctx, scope, file, source)
 }
 
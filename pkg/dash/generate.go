@@ -1,3 +1,8 @@
 package dash
 
 //go:generate go run github.com/mna/pigeon@v1.2.0 -support-left-recursion -o dash.peg.go dash.peg
+
+// The generated entry points are wrapped by the ones in parse.go, which pass
+// along the options that the grammar relies on.
+//go:generate sed -i.orig -E -e "s/^func Parse(File|Reader)?[(]/func parse\\1(/" -e "s/return Parse(Reader)?[(]/return parse\\1(/" dash.peg.go
+//go:generate rm dash.peg.go.orig
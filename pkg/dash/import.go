@@ -0,0 +1,252 @@
+package dash
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/chewxy/hm"
+	"github.com/dagger/dagger/codegen/introspection"
+)
+
+// Import binds the pub slots and classes of another file to a namespace, like
+// `import "./git.dash"`, which is available as git. The name can be chosen
+// with `import "./git.dash" as g`.
+type Import struct {
+	Path  string
+	Alias string
+	Loc   *SourceLocation
+}
+
+var _ Node = Import{}
+
+func (i Import) Body() hm.Expression { return i }
+
+func (i Import) GetSourceLocation() *SourceLocation { return i.Loc }
+
+// Name returns the name the import is bound to: its alias, or else the file
+// name without its extension.
+func (i Import) Name() string {
+	if i.Alias != "" {
+		return i.Alias
+	}
+	base := filepath.Base(i.Path)
+	return strings.TrimSuffix(base, filepath.Ext(base))
+}
+
+// Resolve returns the path of the imported file, relative to the file that
+// imports it.
+func (i Import) Resolve() string {
+	if filepath.IsAbs(i.Path) || i.Loc == nil {
+		return i.Path
+	}
+	return filepath.Join(filepath.Dir(i.Loc.Filename), i.Path)
+}
+
+var _ Hoister = Import{}
+
+func (i Import) Hoist(env hm.Env, fresh hm.Fresher, depth int) error {
+	if depth > 0 {
+		// imports are bound in the first pass, so that they can be used anywhere
+		return nil
+	}
+
+	name := i.Name()
+	if i.Alias == "" && (!isIdentifier(name) || isKeyword(name)) {
+		env.Add(name, hm.NewScheme(nil, errorType{}))
+		return NewInferError(fmt.Errorf("Import.Hoist: %q is not a valid name; use `import %q as name`", name, i.Path), i)
+	}
+
+	mod := env.(*Module)
+	importer, ok := mod.Importer()
+	if !ok {
+		env.Add(name, hm.NewScheme(nil, errorType{}))
+		return NewInferError(fmt.Errorf("Import.Hoist: imports are not supported here"), i)
+	}
+
+	ns, err := importer.Import(i.Resolve())
+	if ns == nil {
+		env.Add(name, hm.NewScheme(nil, errorType{}))
+	} else {
+		env.Add(name, hm.NewScheme(nil, NonNullType{ns}))
+	}
+	if err != nil {
+		return NewInferError(fmt.Errorf("Import.Hoist: %w", err), i)
+	}
+	return nil
+}
+
+func (i Import) Infer(env hm.Env, fresh hm.Fresher) (hm.Type, error) {
+	return WithInferErrorHandling(i, func() (hm.Type, error) {
+		scheme, found := env.SchemeOf(i.Name())
+		if !found {
+			return nil, fmt.Errorf("Import.Infer: %q was not hoisted", i.Name())
+		}
+		t, _ := scheme.Type()
+		if _, failed := t.(errorType); failed {
+			// already reported by Hoist
+			return nil, errSuppressed
+		}
+		return t, nil
+	})
+}
+
+// isIdentifier returns whether name is a valid word, i.e. letters, digits and
+// underscores with single dashes between them.
+func isIdentifier(name string) bool {
+	if name == "" {
+		return false
+	}
+	for _, word := range strings.Split(name, "-") {
+		if word == "" {
+			return false
+		}
+		for _, r := range word {
+			if !('a' <= r && r <= 'z' || 'A' <= r && r <= 'Z' || '0' <= r && r <= '9' || r == '_') {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Importer loads the namespace for an imported file.
+type Importer interface {
+	Import(path string) (*Module, error)
+}
+
+// Loader checks files along with the files they import, loading each file
+// only once.
+type Loader struct {
+	// env is the schema, shared by every file
+	env *Module
+
+	files   map[string]*loadedFile // by absolute path
+	loading []string               // import stack, for detecting cycles
+	paths   map[string]string      // absolute path to path as imported
+
+	// Sources is the content of every file that was read, by file name, so
+	// that errors from any of them can be rendered with an excerpt.
+	Sources map[string][]byte
+}
+
+type loadedFile struct {
+	ns  *Module
+	err error
+}
+
+var _ Importer = (*Loader)(nil)
+
+func NewLoader(schema *introspection.Schema) *Loader {
+	return &Loader{
+		env:     NewEnv(schema),
+		files:   map[string]*loadedFile{},
+		paths:   map[string]string{},
+		Sources: map[string][]byte{},
+	}
+}
+
+// Import loads and checks the file, returning a namespace containing its pub
+// slots and classes. If the file has errors, the namespace contains whatever
+// could be checked, or is nil if it could not be parsed.
+func (l *Loader) Import(path string) (*Module, error) {
+	key, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if f, ok := l.files[key]; ok {
+		return f.ns, f.err
+	}
+
+	for i, loading := range l.loading {
+		if loading == key {
+			var cycle []string
+			for _, p := range l.loading[i:] {
+				cycle = append(cycle, l.paths[p])
+			}
+			cycle = append(cycle, path)
+			return nil, fmt.Errorf("import cycle: %s", strings.Join(cycle, " -> "))
+		}
+	}
+
+	l.paths[key] = path
+	l.loading = append(l.loading, key)
+	ns, err := l.load(path)
+	l.loading = l.loading[:len(l.loading)-1]
+
+	l.files[key] = &loadedFile{ns, err}
+	return ns, err
+}
+
+func (l *Loader) load(path string) (*Module, error) {
+	source, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	l.Sources[path] = source
+
+	parsed, err := Parse(path, source)
+	if err != nil {
+		// the parser recovers from syntax errors and returns what it could
+		// parse, but checking a partial program would just pile on more errors
+		return nil, SyntaxErrors(path, source, err)
+	}
+
+	block := parsed.(Block)
+
+	mod := NewModule(path)
+	mod.Parent = l.env
+	mod.importer = l
+
+	_, err = Infer(mod, block, true)
+
+	// expose whatever checked, even if something didn't
+	return namespace(path, mod, block), err
+}
+
+// Diagnose converts an error returned by Import into diagnostics with
+// excerpts from the files they originated in.
+func (l *Loader) Diagnose(err error) error {
+	if err == nil {
+		return nil
+	}
+	diags := Diagnose(err)
+	if len(diags) == 0 {
+		return err
+	}
+	for _, d := range diags {
+		if d.Loc != nil {
+			d.Source = l.Sources[d.Loc.Filename]
+		}
+	}
+	return diags
+}
+
+// namespace collects the pub slots and the classes of a checked file.
+func namespace(path string, mod *Module, block Block) *Module {
+	base := filepath.Base(path)
+	ns := NewModule(strings.TrimSuffix(base, filepath.Ext(base)))
+	for _, form := range block.Forms {
+		switch x := form.(type) {
+		case SlotDecl:
+			if x.Visibility != PublicVisibility {
+				continue
+			}
+			if scheme, found := mod.LocalSchemeOf(x.Named); found {
+				ns.Add(x.Named, scheme)
+			}
+			addDoc(ns, x.Named, x.Description)
+		case ClassDecl:
+			if class, found := mod.classes[x.Named]; found {
+				ns.AddClass(class)
+			}
+			if scheme, found := mod.LocalSchemeOf(x.Named); found {
+				ns.Add(x.Named, scheme)
+			}
+			addDoc(ns, x.Named, x.Description)
+		}
+	}
+	return ns
+}
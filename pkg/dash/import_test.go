@@ -0,0 +1,151 @@
+package dash
+
+import "testing"
+
+func TestImports(t *testing.T) {
+	runCheckCases(t, []checkCase{
+		{
+			Name: "pub slot",
+			Files: map[string]string{
+				"lib.dash": `pub greeting = "hello"`,
+			},
+			Src: `import "./lib.dash"
+pub s: String! = lib.greeting`,
+		},
+		{
+			Name: "pub function",
+			Files: map[string]string{
+				"lib.dash": `pub alpine(cmd: String!): Container! { container.from("alpine").with-exec([cmd]) }`,
+			},
+			Src: `import "./lib.dash"
+pub c: Container! = lib.alpine("ls")`,
+		},
+		{
+			Name: "pvt slot",
+			Files: map[string]string{
+				"lib.dash": `pvt secret = "shh"
+pub greeting = secret`,
+			},
+			Src: `import "./lib.dash"
+pub s = lib.secret`,
+			Err: `field "secret" not found in record lib`,
+		},
+		{
+			Name: "pvt slot used by a pub slot",
+			Files: map[string]string{
+				"lib.dash": `pvt secret = "shh"
+pub greeting = secret`,
+			},
+			Src: `import "./lib.dash"
+pub s: String! = lib.greeting`,
+		},
+		{
+			Name: "class",
+			Files: map[string]string{
+				"lib.dash": `cls Greeter {
+  pub name: String!
+  pub greet: String! = "hello, " + name
+}`,
+			},
+			Src: `import "./lib.dash"
+pub s: String! = lib.Greeter(name: "dash").greet`,
+		},
+		{
+			Name: "alias",
+			Files: map[string]string{
+				"lib.dash": `pub greeting = "hello"`,
+			},
+			Src: `import "./lib.dash" as l
+pub s: String! = l.greeting`,
+		},
+		{
+			Name: "alias hides the file name",
+			Files: map[string]string{
+				"lib.dash": `pub greeting = "hello"`,
+			},
+			Src: `import "./lib.dash" as l
+pub s = lib.greeting`,
+			Err: `"lib" not found`,
+		},
+		{
+			Name: "name that isn't an identifier",
+			Files: map[string]string{
+				"my.lib.dash": `pub greeting = "hello"`,
+			},
+			Src: `import "./my.lib.dash"`,
+			Err: `"my.lib" is not a valid name; use ` + "`import \"./my.lib.dash\" as name`",
+		},
+		{
+			Name: "name that is a keyword",
+			Files: map[string]string{
+				"case.dash": `pub greeting = "hello"`,
+			},
+			Src: `import "./case.dash"`,
+			Err: `"case" is not a valid name`,
+		},
+		{
+			Name: "import from a subdirectory",
+			Files: map[string]string{
+				"lib/a.dash": `import "./b.dash"
+pub greeting = b.greeting`,
+				"lib/b.dash": `pub greeting = "hello"`,
+			},
+			Src: `import "./lib/a.dash"
+pub s: String! = a.greeting`,
+		},
+		{
+			Name: "same file imported twice",
+			Files: map[string]string{
+				"a.dash": `import "./lib.dash"
+pub x = lib.greeting`,
+				"lib.dash": `pub greeting = "hello"`,
+			},
+			Src: `import "./a.dash"
+import "./lib.dash"
+pub s: String! = lib.greeting`,
+		},
+		{
+			Name: "used before the import",
+			Files: map[string]string{
+				"lib.dash": `pub greeting = "hello"`,
+			},
+			Src: `pub s: String! = lib.greeting
+import "./lib.dash"`,
+		},
+		{
+			Name: "missing file",
+			Src:  `import "./nope.dash"`,
+			Err:  "nope.dash: no such file or directory",
+		},
+		{
+			Name: "error in the imported file",
+			Files: map[string]string{
+				"lib.dash": `pub greeting: Int! = "hello"`,
+			},
+			Src: `import "./lib.dash"`,
+			Err: "lib.dash:1:",
+		},
+		{
+			Name: "syntax error in the imported file",
+			Files: map[string]string{
+				"lib.dash": `pub greeting = )`,
+			},
+			Src: `import "./lib.dash"`,
+			Err: "lib.dash:1:16: syntax error",
+		},
+		{
+			Name: "self import",
+			Src:  `import "./main.dash"`,
+			Err:  "import cycle",
+		},
+		{
+			Name: "import cycle",
+			Files: map[string]string{
+				"a.dash": `import "./b.dash"`,
+				"b.dash": `import "./a.dash"`,
+			},
+			Src: `import "./a.dash"`,
+			Err: "b.dash:1:1: Import.Hoist: import cycle:",
+		},
+	})
+}
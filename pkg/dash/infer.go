@@ -1,6 +1,7 @@
 package dash
 
 import (
+	stderrors "errors"
 	"fmt"
 	"log"
 
@@ -10,26 +11,122 @@ import (
 
 type inferer struct {
 	env hm.Env
-	cs  Constraints
 	t   Type
 
+	// constraints are solved as they're generated, so that an error can be
+	// reported at the node that introduced it
+	solver
+
 	count int
 }
 
 func newInferer(env hm.Env) *inferer {
 	return &inferer{
 		env: env,
+		solver: solver{
+			nullable: Set[hm.TypeVariable]{},
+		},
 	}
 }
 
-const letters = `abcdefghijklmnopqrstuvwxyz`
+// freshBase is the first fresh type variable. Fresh variables are numbered
+// from α so that they never collide with the variables written in signatures,
+// which are ASCII letters.
+const freshBase = 'α'
 
 func (infer *inferer) Fresh() hm.TypeVariable {
-	retVal := letters[infer.count]
+	retVal := freshBase + rune(infer.count)
+	if retVal >= 0xD800 {
+		// skip surrogates, which are not valid runes
+		retVal += 0xE000 - 0xD800
+	}
 	infer.count++
 	return hm.TypeVariable(retVal)
 }
 
+// unify adds a constraint that two types are the same. Inference accumulates
+// the substitution that solves the constraints; without an inferer the
+// constraint is only checked.
+func unify(fresh hm.Fresher, a, b hm.Type) error {
+	if infer, ok := fresh.(*inferer); ok {
+		return infer.solve(Constraint{a, b})
+	}
+	_, err := hm.Unify(cloneType(a), cloneType(b))
+	return err
+}
+
+// apply substitutes the type variables that have been solved so far in t.
+func apply(fresh hm.Fresher, t hm.Type) hm.Type {
+	if infer, ok := fresh.(*inferer); ok {
+		return applyType(infer.sub, t)
+	}
+	return t
+}
+
+// instantiate returns the type of a scheme with fresh type variables in place
+// of its quantified ones, so that each use of a polymorphic slot can pick its
+// own types.
+func instantiate(fresh hm.Fresher, s *hm.Scheme) hm.Type {
+	t, _ := s.Type()
+	bound := t.FreeTypeVar().Difference(s.FreeTypeVar())
+	if len(bound) == 0 {
+		return apply(fresh, t)
+	}
+	var subs hm.Subs = hm.BorrowMSubs()
+	for _, tv := range bound {
+		fr := fresh.Fresh()
+		if infer, ok := fresh.(*inferer); ok {
+			// a null stays null, e.g. pvt none = null
+			if _, null := infer.nullable[tv]; null {
+				infer.nullable[fr] = struct{}{}
+			}
+		}
+		subs = subs.Add(tv, fr)
+	}
+	return apply(fresh, applyType(subs, t))
+}
+
+// freshNull returns the type of null: a fresh type variable that may only be
+// bound to a nullable type.
+func freshNull(fresh hm.Fresher) hm.TypeVariable {
+	tv := fresh.Fresh()
+	if infer, ok := fresh.(*inferer); ok {
+		infer.nullable[tv] = struct{}{}
+	}
+	return tv
+}
+
+// isNull returns whether t is the type of null, i.e. it may only be bound to a
+// nullable type, but it is not yet known which.
+func isNull(fresh hm.Fresher, t hm.Type) bool {
+	tv, ok := t.(hm.TypeVariable)
+	if !ok {
+		return false
+	}
+	infer, ok := fresh.(*inferer)
+	if !ok {
+		return false
+	}
+	_, null := infer.nullable[tv]
+	return null
+}
+
+// generalize quantifies t over the type variables that it doesn't share with
+// the env, so that a slot like id(x: a): a can be used at many types. The
+// substitution solved so far is written back into the env first, so that the
+// env's types are up to date.
+func generalize(env hm.Env, fresh hm.Fresher, t hm.Type) *hm.Scheme {
+	t = apply(fresh, t)
+	var envVars hm.TypeVarSet
+	if mod, ok := env.(*Module); ok {
+		if infer, ok := fresh.(*inferer); ok {
+			mod.applySubs(infer.sub)
+		}
+		envVars = mod.freeTypeVars()
+	}
+	return hm.NewScheme(t.FreeTypeVar().Difference(envVars), t)
+}
+
 func (infer *inferer) lookup(name string) error {
 	s, ok := infer.env.SchemeOf(name)
 	if !ok {
@@ -180,34 +277,40 @@ func Infer(env hm.Env, expr hm.Expression, hoist bool) (*hm.Scheme, error) {
 
 	infer := newInferer(env)
 
+	// collect errors from hoisting and inference rather than stopping at the
+	// first one; whatever failed to hoist is still reported during inference,
+	// duplicates are removed by Diagnose
+	var errs []error
+
 	if hoister, ok := expr.(Hoister); ok {
 		// Hoist in two passes. This could maybe be a boolean, but leaving it as an
 		// integer in case I need it later (as much of a smell as that may be)
 		if err := hoister.Hoist(env, infer, 0); err != nil {
-			return nil, fmt.Errorf("Block.Hoist: %w", err)
+			errs = append(errs, fmt.Errorf("Block.Hoist: %w", err))
 		}
 		if err := hoister.Hoist(env, infer, 1); err != nil {
-			return nil, fmt.Errorf("Block.Hoist: %w", err)
+			errs = append(errs, fmt.Errorf("Block.Hoist: %w", err))
 		}
 		log.Println("HOISTED")
 	}
 
 	if err := infer.consGen(expr); err != nil {
-		return nil, err
+		errs = append(errs, err)
 	}
 
-	s := newSolver()
-	s.solve(infer.cs)
-
-	if s.err != nil {
-		return nil, s.err
+	if len(errs) > 0 {
+		return nil, stderrors.Join(errs...)
 	}
 
 	if infer.t == nil {
 		return nil, errors.Errorf("infer.t is nil")
 	}
 
-	t := infer.t.Apply(s.sub).(Type)
+	if mod, ok := env.(*Module); ok {
+		mod.applySubs(infer.sub)
+	}
+
+	t := applyType(infer.sub, infer.t)
 	return closeOver(t)
 }
 
@@ -217,13 +320,13 @@ func closeOver(t Type) (sch *hm.Scheme, err error) {
 	return
 }
 
+// solver accumulates the substitution that solves the constraints it is given.
 type solver struct {
 	sub hm.Subs
-	err error
-}
 
-func newSolver() *solver {
-	return new(solver)
+	// nullable is the set of type variables that may only be bound to nullable
+	// types, i.e. the types of nulls
+	nullable Set[hm.TypeVariable]
 }
 
 type Constraints []Constraint
@@ -267,24 +370,82 @@ func (cs Constraints) Format(state fmt.State, c rune) {
 	state.Write([]byte{']'})
 }
 
-func (s *solver) solve(cs Constraints) {
-	if s.err != nil {
-		return
+// solve unifies the types of a constraint under the substitution found so
+// far, and composes the result into it. A constraint that can't be solved
+// leaves the substitution as it was.
+func (s *solver) solve(c Constraint) error {
+	c = Constraint{cloneType(c.a), cloneType(c.b)}.Apply(s.sub).(Constraint)
+	sub, err := hm.Unify(c.a, c.b)
+	if err != nil {
+		if nullable, nonNull, found := nullabilityMismatch(c.a, c.b); found {
+			return fmt.Errorf("%s is nullable, but %s is not", nullable, nonNull)
+		}
+		return err
 	}
+	if err := s.checkNullable(sub); err != nil {
+		return err
+	}
+	s.sub = compose(sub, s.sub)
+	return nil
+}
 
-	switch len(cs) {
-	case 0:
-		return
-	default:
-		var sub hm.Subs
-		c := cs[0]
-		sub, s.err = hm.Unify(c.a, c.b)
-		defer hm.ReturnSubs(s.sub)
+// checkNullable returns an error if a substitution binds the type of a null to
+// a type that may not be null. Variables that it is bound to become nullable in
+// turn.
+func (s *solver) checkNullable(sub hm.Subs) error {
+	if sub == nil {
+		return nil
+	}
+	var vars []hm.TypeVariable
+	for _, b := range sub.Iter() {
+		if _, null := s.nullable[b.Tv]; !null {
+			continue
+		}
+		switch t := b.T.(type) {
+		case NonNullType:
+			return fmt.Errorf("null is not allowed as %s", t)
+		case rigidVar:
+			// the caller may choose a non-null type
+			return fmt.Errorf("null is not allowed as %s, which may be non-null", t)
+		case hm.TypeVariable:
+			vars = append(vars, t)
+		}
+	}
+	for _, tv := range vars {
+		s.nullable[tv] = struct{}{}
+	}
+	return nil
+}
 
-		s.sub = compose(sub, s.sub)
-		cs = cs[1:].Apply(s.sub).(Constraints)
-		s.solve(cs)
+// nullabilityMismatch finds where one type is nullable and the other isn't,
+// which hm reports confusingly since NonNullType has a type and others don't.
+func nullabilityMismatch(a, b hm.Type) (nullable, nonNull hm.Type, found bool) {
+	if isVar(a) || isVar(b) {
+		return nil, nil, false
+	}
+	ann, aNonNull := a.(NonNullType)
+	bnn, bNonNull := b.(NonNullType)
+	switch {
+	case aNonNull && bNonNull:
+		return nullabilityMismatch(ann.Type, bnn.Type)
+	case aNonNull:
+		return b, a, unifiable(ann.Type, b)
+	case bNonNull:
+		return a, b, unifiable(a, bnn.Type)
+	}
+	if al, ok := a.(ListType); ok {
+		if bl, ok := b.(ListType); ok {
+			return nullabilityMismatch(al.Type, bl.Type)
+		}
 	}
+	return nil, nil, false
+}
+
+// unifiable returns whether two types can be unified, without substituting
+// them.
+func unifiable(a, b hm.Type) bool {
+	_, err := hm.Unify(cloneType(a), cloneType(b))
+	return err == nil
 }
 
 func compose(a, b hm.Subs) (retVal hm.Subs) {
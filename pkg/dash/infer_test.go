@@ -0,0 +1,84 @@
+package dash
+
+import "testing"
+
+func TestInference(t *testing.T) {
+	runCheckCases(t, []checkCase{
+		{
+			Name: "polymorphic slot used at many types",
+			Src: `pub id(x: a): a { x }
+pub i: Int! = id(1)
+pub s: String! = id("x")`,
+		},
+		{
+			Name: "polymorphic slot result used at the wrong type",
+			Src: `pub id(x: a): a { x }
+pub i: Int! = id("x")`,
+			Err: "String ~ Int",
+		},
+		{
+			Name: "inferred lambda",
+			Src: `pub inc = fn(x) -> x + 1
+pub i: Int! = inc(1)`,
+		},
+		{
+			Name: "inferred lambda applied to the wrong type",
+			Src: `pub inc = fn(x) -> x + 1
+pub i = inc("x")`,
+			Err: `"x" cannot unify`,
+		},
+		{
+			Name: "lambda argument typed by the function it's passed to",
+			Src: `pub apply(f: fn(x: Int!): String!, x: Int!): String! { f(x) }
+pub s = apply(fn(x) -> "${x}", 1)`,
+		},
+		{
+			Name: "call an inferred function",
+			Src: `pub call1 = fn(g) -> g(1)
+pub i: Int! = call1(fn(n) -> n)
+pub s: String! = call1(fn(n) -> "${n}")`,
+		},
+		{
+			Name: "call an inferred function twice",
+			Src: `pub twice = fn(f, x) -> f(f(x))
+pub i: Int! = twice(fn(n) -> n + 1, 1)`,
+		},
+		{
+			Name: "call an inferred function with named arguments",
+			Src: `pub call1 = fn(g) -> g(n: 1)
+pub i: Int! = call1(fn(n) -> n)`,
+		},
+		{
+			Name: "call an inferred function inconsistently",
+			Src:  `pub bad = fn(g) -> g(1) + g("x")`,
+			Err:  "Int ~ String",
+		},
+		{
+			Name: "pass a function of the wrong type",
+			Src: `pub call1 = fn(g) -> g(1)
+pub s: String! = call1(fn(n: String!) -> n)`,
+			Err: "Int ~ String",
+		},
+		{
+			Name: "call a non-function",
+			Src: `pub x = 1
+pub y = x(1)`,
+			Err: "expected function, got Int!",
+		},
+		{
+			Name: "call a non-function without arguments",
+			Src: `pub x = 1
+pub y = x()`,
+			Err: "expected function, got Int!",
+		},
+		{
+			Name: "call a field without arguments",
+			Src:  `pub s: String! = container().from("alpine").stdout()`,
+		},
+		{
+			Name: "call an inferred function without arguments",
+			Src: `pub call0 = fn(g) -> g()
+pub i: Int! = call0(fn() -> 1)`,
+		},
+	})
+}
@@ -0,0 +1,58 @@
+package dash
+
+import "testing"
+
+func TestInputObjects(t *testing.T) {
+	runCheckCases(t, []checkCase{
+		{
+			Name: "record where an input object is expected",
+			Src:  `pub c = container.build(build-args: [{name: "A", value: "B"}])`,
+		},
+		{
+			Name: "single record where a list is expected",
+			Src:  `pub c = container.build(build-args: {name: "A", value: "B"})`,
+		},
+		{
+			Name: "records on separate lines",
+			Src: `pub c = container.build(build-args: [
+  {name: "A", value: "B"}
+  {name: "C", value: "D"}
+])`,
+		},
+		{
+			Name: "missing field",
+			Src:  `pub c = container.build(build-args: [{name: "A"}])`,
+			Err:  `BuildArg is missing required field "value"`,
+		},
+		{
+			Name: "missing fields",
+			Src:  `pub c = container.build(build-args: [{name: "A", value: "B"}, {nam: "C"}])`,
+			Err:  `BuildArg is missing required fields "name" and "value"`,
+		},
+		{
+			Name: "unknown field",
+			Src:  `pub c = container.build(build-args: [{name: "A", valu: "B"}])`,
+			Err:  `"valu" is not a field of BuildArg`,
+		},
+		{
+			Name: "field of the wrong type",
+			Src:  `pub c = container.build(build-args: [{name: "A", value: 1}])`,
+			Err:  `field "value" cannot unify`,
+		},
+		{
+			Name: "null field",
+			Src:  `pub c = container.build(build-args: [{name: "A", value: null}])`,
+			Err:  "null is not allowed as String!",
+		},
+		{
+			Name: "duplicate field",
+			Src:  `pub c = container.build(build-args: [{name: "A", name: "B", value: "C"}])`,
+			Err:  `duplicate field "name"`,
+		},
+		{
+			Name: "error location",
+			Src:  `pub c = container.build(build-args: [{name: "A", value: 1}])`,
+			Err:  "main.dash:1:57:",
+		},
+	})
+}
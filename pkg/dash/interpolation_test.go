@@ -0,0 +1,43 @@
+package dash
+
+import "testing"
+
+func TestInterpolation(t *testing.T) {
+	runCheckCases(t, []checkCase{
+		{
+			Name: "scalars",
+			Src:  `pub s = "a${"b"} ${1} ${2.5} ${true}"`,
+		},
+		{
+			Name: "object",
+			Src:  `pub s = "a${container}"`,
+			Err:  "cannot interpolate Container! into a string",
+		},
+		{
+			Name: "nullable",
+			Src: `pub maybe: String = null
+pub s = "a${maybe}"`,
+			Err: "cannot interpolate String into a string",
+		},
+		{
+			Name: "null",
+			Src:  `pub s = "a${null}"`,
+			Err:  "cannot interpolate null into a string",
+		},
+		{
+			Name: "null through a slot",
+			Src: `pvt none = null
+pub s = "a${none}"`,
+			Err: "cannot interpolate null into a string",
+		},
+		{
+			Name: "inferred argument",
+			Src:  `pub f = fn(x) -> "a${x}"`,
+		},
+		{
+			Name: "type variable argument",
+			Src:  `pub f(x: a): String! { "a${x}" }`,
+			Err:  "cannot interpolate a into a string",
+		},
+	})
+}
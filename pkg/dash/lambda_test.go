@@ -0,0 +1,75 @@
+package dash
+
+import "testing"
+
+func TestLambdas(t *testing.T) {
+	runCheckCases(t, []checkCase{
+		{
+			Name: "typed lambda with a block",
+			Src: `pub inc = fn(x: Int!): Int! { x + 1 }
+pub i: Int! = inc(1)`,
+		},
+		{
+			Name: "untyped lambda with a block",
+			Src: `pub inc = fn(x) { x + 1 }
+pub i: Int! = inc(1)`,
+		},
+		{
+			Name: "arrow lambda",
+			Src: `pub inc = fn(x) -> x + 1
+pub i: Int! = inc(x: 1)`,
+		},
+		{
+			Name: "lambda without arguments",
+			Src: `pub one = fn() -> 1
+pub i: Int! = one()`,
+		},
+		{
+			Name: "body doesn't match the return type",
+			Src:  `pub f = fn(x: Int!): String! { x }`,
+			Err:  "lambda mismatch: defined as String!, inferred as Int!",
+		},
+		{
+			Name: "argument of the wrong type",
+			Src: `pub f = fn(x: Int!): Int! { x }
+pub i = f("one")`,
+			Err: `"x" cannot unify`,
+		},
+		{
+			Name: "closes over the enclosing scope",
+			Src: `pub base = container.from("alpine")
+pub run = fn(cmd: String!) -> base.with-exec([cmd])
+pub c: Container! = run("ls")`,
+		},
+		{
+			Name: "closes over arguments",
+			Src: `pub adder(n: Int!): fn(x: Int!): Int! { fn(x) -> x + n }
+pub i: Int! = adder(1)(2)`,
+		},
+		{
+			Name: "unknown name in the body",
+			Src:  `pub f = fn(x) -> y`,
+			Err:  `"y" not found`,
+		},
+		{
+			Name: "passed as an argument",
+			Src: `pub go-cache = fn(c: Container!): Container! { c.with-env-variable(name: "GOCACHE", value: "/cache") }
+pub c = container.from("golang").with(go-cache)`,
+		},
+		{
+			Name: "passed inline",
+			Src:  `pub c = container.from("golang").with(fn(c) -> c.with-exec(["go", "build"]))`,
+		},
+		{
+			Name: "passed with the wrong type",
+			Src:  `pub c = container.from("golang").with(fn(c: String!) -> c)`,
+			Err:  "Container ~ String",
+		},
+		{
+			Name: "arguments are not visible outside",
+			Src: `pub f = fn(x: Int!) -> x
+pub y = x`,
+			Err: `"x" not found`,
+		},
+	})
+}
@@ -0,0 +1,28 @@
+package dash
+
+import "testing"
+
+func TestLists(t *testing.T) {
+	runCheckCases(t, []checkCase{
+		{
+			Name: "elements of the same type",
+			Src:  `pub l: [Int!]! = [1, 2, 3]`,
+		},
+		{
+			Name: "elements of mismatched types",
+			Src:  `pub l = [1, "two"]`,
+			Err:  "element 1 has type String!, expected Int!",
+		},
+		{
+			Name: "type variable elements",
+			Src: `pub f = fn(a, b) -> [a, b]
+pub l: [Int!]! = f(1, 2)`,
+		},
+		{
+			Name: "type variable elements with mismatched arguments",
+			Src: `pub f = fn(a, b) -> [a, b]
+pub l = f(1, "s")`,
+			Err: "cannot unify",
+		},
+	})
+}
@@ -0,0 +1,72 @@
+package dash
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/chewxy/hm"
+)
+
+// KebabCase converts a GraphQL name like withExec to its name in dash,
+// with-exec. A run of upper case letters is one word, except for a last one
+// that starts a lower case word; e.g. containerID becomes container-id, and
+// HTTPServer becomes http-server. A lone s after the run pluralizes it, so
+// withCPUs becomes with-cpus.
+//
+// Since this can't always be reversed, the schema's name is kept alongside
+// the installed one; see Module.GraphQLName.
+func KebabCase(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				prev := runes[i-1]
+				acronymEnd := unicode.IsUpper(prev) && i+1 < len(runes) && unicode.IsLower(runes[i+1]) && !plural(runes, i+1)
+				if unicode.IsLower(prev) || unicode.IsDigit(prev) || acronymEnd {
+					b.WriteByte('-')
+				}
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// plural returns whether runes[i] is an s that ends a word on its own.
+func plural(runes []rune, i int) bool {
+	return runes[i] == 's' && (i+1 == len(runes) || !unicode.IsLower(runes[i+1]))
+}
+
+// CamelCase converts a dash name like with-exec to its GraphQL name, withExec.
+// Names from the schema should be looked up with GraphQLName instead, since
+// e.g. container-id may have been containerID.
+func CamelCase(name string) string {
+	words := strings.Split(name, "-")
+	for i, word := range words[1:] {
+		r, w := utf8.DecodeRuneInString(word)
+		words[i+1] = string(unicode.ToUpper(r)) + word[w:]
+	}
+	return strings.Join(words, "")
+}
+
+// didYouMean returns a hint suggesting the dash spelling of a camelCase name
+// if that is what's defined, or an empty string otherwise.
+func didYouMean(name string, defined func(string) bool) string {
+	kebab := KebabCase(name)
+	if kebab == name || !defined(kebab) {
+		return ""
+	}
+	return fmt.Sprintf("; did you mean %s?", kebab)
+}
+
+// hasScheme returns a function reporting whether a name is bound in env.
+func hasScheme(env hm.Env) func(string) bool {
+	return func(name string) bool {
+		_, found := env.SchemeOf(name)
+		return found
+	}
+}
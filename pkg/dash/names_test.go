@@ -0,0 +1,69 @@
+package dash
+
+import (
+	"testing"
+
+	"github.com/chewxy/hm"
+)
+
+func TestKebabCase(t *testing.T) {
+	for _, c := range []struct {
+		GraphQL string
+		Dash    string
+	}{
+		{"from", "from"},
+		{"withExec", "with-exec"},
+		{"containerID", "container-id"},
+		{"loadContainerFromID", "load-container-from-id"},
+		{"HTTPServer", "http-server"},
+		{"withHTTPProxy", "with-http-proxy"},
+		{"sha256Sum", "sha256-sum"},
+		{"withCPUs", "with-cpus"},
+		{"containerIDs", "container-ids"},
+		{"URLsFor", "urls-for"},
+	} {
+		if got := KebabCase(c.GraphQL); got != c.Dash {
+			t.Errorf("KebabCase(%q) = %q, expected %q", c.GraphQL, got, c.Dash)
+		}
+	}
+}
+
+func TestGraphQLName(t *testing.T) {
+	env := NewEnv(testSchema())
+	query := env.Parent
+
+	if got := query.GraphQLName("load-container-from-id"); got != "loadContainerFromID" {
+		t.Errorf("expected loadContainerFromID, got %q", got)
+	}
+
+	scheme, _ := query.SchemeOf("load-container-from-id")
+	ft, _ := scheme.Type()
+	args := ft.(*hm.FunctionType).Arg().(*RecordType)
+	if got := args.GraphQLName("id"); got != "id" {
+		t.Errorf("expected id, got %q", got)
+	}
+
+	container, _ := env.NamedType("Container")
+	if got := container.GraphQLName("with-cpus"); got != "withCPUs" {
+		t.Errorf("expected withCPUs, got %q", got)
+	}
+
+	// slots defined in dash are converted
+	if got := container.GraphQLName("with-foo"); got != "withFoo" {
+		t.Errorf("expected withFoo, got %q", got)
+	}
+}
+
+func TestSchemaNames(t *testing.T) {
+	runCheckCases(t, []checkCase{
+		{
+			Name: "acronym",
+			Src:  `pub c = load-container-from-id(id: container.id)`,
+		},
+		{
+			Name: "camelCase name",
+			Src:  `pub c = loadContainerFromID(id: container.id)`,
+			Err:  "did you mean load-container-from-id?",
+		},
+	})
+}
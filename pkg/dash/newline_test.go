@@ -0,0 +1,81 @@
+package dash
+
+import "testing"
+
+// TestNewlines covers when a newline terminates a form. The tree-sitter
+// grammar's external scanner (treesitter/src/scanner.c) must agree.
+func TestNewlines(t *testing.T) {
+	runCheckCases(t, []checkCase{
+		{
+			Name: "forms on separate lines",
+			Src: `pub a = 1
+pub b = 2`,
+		},
+		{
+			Name: "forms on the same line",
+			Src:  `pub a = 1 pub b = 2`,
+			Err:  "syntax error",
+		},
+		{
+			Name: "forms separated by commas and semicolons",
+			Src:  `pub a = 1, pub b = 2; pub c = 3`,
+		},
+		{
+			Name: "comment before newline",
+			Src: `pub a = 1 # one
+pub b = 2`,
+		},
+		{
+			Name: "operator on the next line",
+			Src: `pub a = 1
+- 2`,
+			Err: "syntax error",
+		},
+		{
+			Name: "operator at the end of the line",
+			Src: `pub a = 1 -
+  2`,
+		},
+		{
+			Name: "leading dots",
+			Src: `pub c = container
+  # a comment doesn't end the chain
+  .from("alpine")
+
+  .with-exec(["echo", "hi"])`,
+		},
+		{
+			Name: "trailing dots",
+			Src: `pub c = container.
+  from("alpine").
+  with-exec(["echo", "hi"])`,
+		},
+		{
+			Name: "exec on the next line",
+			Src: `pub c = container.from("alpine")
+  $ echo hi;`,
+		},
+		{
+			Name: "else on the next line",
+			Src: `pub a = if true { 1 }
+else { 2 }`,
+		},
+		{
+			Name: "slot block on the next line",
+			Src: `pub f: Int!
+{ 1 }`,
+			Err: "syntax error",
+		},
+		{
+			Name: "slot block on the same line",
+			Src: `pub f: Int! {
+  1
+}
+pub g(x: Int!): Int! { x }`,
+		},
+		{
+			Name: "last form in a block",
+			Src:  `pub f: Int! { 1 }`,
+		},
+	})
+}
@@ -0,0 +1,107 @@
+package dash
+
+import "testing"
+
+func TestNullability(t *testing.T) {
+	runCheckCases(t, []checkCase{
+		{
+			Name: "non-null where nullable is expected",
+			Src:  `pub c = container.with-env-variable(name: "A", value: "B", expand: true)`,
+		},
+		{
+			Name: "null where nullable is expected",
+			Src:  `pub c = container.with-env-variable(name: "A", value: "B", expand: null)`,
+		},
+		{
+			Name: "null slot",
+			Src:  `pub x: String = null`,
+		},
+		{
+			Name: "null where non-null is expected",
+			Src:  `pub x: String! = null`,
+			Err:  "null is not allowed as String!",
+		},
+		{
+			Name: "null schema argument",
+			Src:  `pub c = container.from(address: null)`,
+			Err:  "null is not allowed as String!",
+		},
+		{
+			Name: "null argument",
+			Src: `pub f(s: String!): String! { s }
+pub x = f(null)`,
+			Err: "null is not allowed as String!",
+		},
+		{
+			Name: "null default",
+			Src:  `pub f(s: String! = null): String! { s }`,
+			Err:  `"s" mismatch`,
+		},
+		{
+			Name: "null through a slot",
+			Src: `pvt none = null
+pub x: Int! = none`,
+			Err: "null is not allowed as Int!",
+		},
+		{
+			Name: "null through a slot where nullable is expected",
+			Src: `pvt none = null
+pub x: Int = none
+pub y: String = none`,
+		},
+		{
+			Name: "null for a type variable",
+			Src:  `pub f(x: a): a { null }`,
+			Err:  `"f" mismatch`,
+		},
+		{
+			Name: "list of null",
+			Src:  `pub l: [String!]! = [null]`,
+			Err:  "null is not allowed as String!",
+		},
+		{
+			Name: "list of null and non-null",
+			Src:  `pub l: [Int]! = [null, 1]`,
+		},
+		{
+			Name: "list of non-null where nullable elements are expected",
+			Src:  `pub l: [String] = ["a", "b"]`,
+		},
+		{
+			Name: "nullable where non-null is expected",
+			Src: `pub maybe: String = null
+pub x: String! = maybe`,
+			Err: "String is nullable, but String! is not",
+		},
+		{
+			Name: "nullable list elements where non-null are expected",
+			Src: `pub maybe: String = null
+pub c = container.with-exec([maybe])`,
+			Err: "String is nullable, but String! is not",
+		},
+		{
+			Name: "single value where a list is expected",
+			Src:  `pub c = container.with-exec("ls")`,
+		},
+		{
+			Name: "default narrows to non-null",
+			Src: `pub maybe: String = null
+pub x: String! = maybe ? "fallback"`,
+		},
+		{
+			Name: "default with nullable right side",
+			Src: `pub maybe: String = null
+pub x: String = maybe ? null`,
+		},
+		{
+			Name: "default with nullable right side is nullable",
+			Src: `pub maybe: String = null
+pub x: String! = maybe ? maybe`,
+			Err: "String is nullable, but String! is not",
+		},
+		{
+			Name: "default of null",
+			Src:  `pub x: Int! = null ? 1`,
+		},
+	})
+}
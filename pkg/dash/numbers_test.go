@@ -0,0 +1,73 @@
+package dash
+
+import "testing"
+
+func TestNumbers(t *testing.T) {
+	runCheckCases(t, []checkCase{
+		{
+			Name: "Int as Float",
+			Src:  `pub x: Float! = 1`,
+		},
+		{
+			Name: "Int argument as Float",
+			Src:  `pub c = container.with-cpus(cpus: 2)`,
+		},
+		{
+			Name: "Float as Int",
+			Src:  `pub x: Int! = 1.5`,
+			Err:  "Float ~ Int",
+		},
+		{
+			Name: "mixed list as floats",
+			Src:  `pub l: [Float!]! = [1, 2.5]`,
+		},
+		{
+			Name: "list of Ints as floats",
+			Src:  `pub l: [Float!]! = [1, 2]`,
+		},
+		{
+			Name: "nested list as floats",
+			Src:  `pub l: [[Float!]!]! = [[1], [2.5, 3]]`,
+		},
+		{
+			Name: "mixed list argument",
+			Src: `pub sum(xs: [Float!]!): Float! { 0.0 }
+pub x = sum([1, 2.5])`,
+		},
+		{
+			Name: "mixed list of nullable floats",
+			Src:  `pub l: [Float] = [1, null, 2.5]`,
+		},
+		{
+			Name: "Float in a list of Ints",
+			Src:  `pub l: [Int!]! = [1, 2.5]`,
+			Err:  "element 1 has type Float!, expected Int!",
+		},
+		{
+			Name: "Int out of range",
+			Src:  `pub x = 99999999999999999999`,
+			Err:  "99999999999999999999 is out of range for Int",
+		},
+		{
+			Name: "Float out of range",
+			Src: `pub x = 1
+pub y = 1e999`,
+			Err: "main.dash:2:9: 1e999 is out of range for Float",
+		},
+	})
+}
+
+func TestEnvScalars(t *testing.T) {
+	a := NewEnv(testSchema())
+	b := NewEnv(testSchema())
+	for _, name := range []string{"Boolean", "String", "Int", "Float"} {
+		at, found := a.NamedType(name)
+		if !found {
+			t.Fatalf("%s not found", name)
+		}
+		bt, _ := b.NamedType(name)
+		if at == bt {
+			t.Errorf("%s is shared between envs", name)
+		}
+	}
+}
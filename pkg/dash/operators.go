@@ -0,0 +1,209 @@
+package dash
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/chewxy/hm"
+)
+
+// AddOperator adds an overload for an operator. Binary operators are typed
+// as curried functions of their operands, e.g. Int! -> Int! -> Int!.
+// Polymorphic overloads quantify over their type variables.
+func (e *Module) AddOperator(op string, s *hm.Scheme) *Module {
+	e.operators[op] = append(e.operators[op], s)
+	return e
+}
+
+// OperatorsOf returns the overloads of an operator, in the order they were
+// added.
+func (e *Module) OperatorsOf(op string) []*hm.Scheme {
+	if ops, ok := e.operators[op]; ok {
+		return ops
+	}
+	if e.Parent != nil {
+		return e.Parent.OperatorsOf(op)
+	}
+	return nil
+}
+
+// installOperators adds the built-in operators to the given module.
+func installOperators(mod *Module) error {
+	named := func(name string) (hm.Type, error) {
+		t, found := mod.NamedType(name)
+		if !found {
+			return nil, fmt.Errorf("installOperators: %q not found", name)
+		}
+		return NonNullType{t}, nil
+	}
+	int_, err := named("Int")
+	if err != nil {
+		return err
+	}
+	str, err := named("String")
+	if err != nil {
+		return err
+	}
+	bool_, err := named("Boolean")
+	if err != nil {
+		return err
+	}
+	float, err := named("Float")
+	if err != nil {
+		return err
+	}
+
+	a := hm.TypeVariable('a')
+	list := NonNullType{ListType{a}}
+
+	mono := func(t hm.Type) *hm.Scheme { return hm.NewScheme(nil, t) }
+	poly := func(t hm.Type) *hm.Scheme { return hm.NewScheme(hm.TypeVarSet{a}, t) }
+
+	// arithmetic on two Ints stays an Int, including division; an Int mixed
+	// with a Float is widened to a Float
+	numeric := func(op string, ret func(hm.Type) hm.Type) {
+		mod.AddOperator(op, mono(hm.NewFnType(int_, int_, ret(int_))))
+		mod.AddOperator(op, mono(hm.NewFnType(float, float, ret(float))))
+		mod.AddOperator(op, mono(hm.NewFnType(int_, float, ret(float))))
+		mod.AddOperator(op, mono(hm.NewFnType(float, int_, ret(float))))
+	}
+	same := func(t hm.Type) hm.Type { return t }
+	boolean := func(hm.Type) hm.Type { return bool_ }
+
+	numeric("+", same)
+	mod.AddOperator("+", mono(hm.NewFnType(str, str, str)))
+	mod.AddOperator("+", poly(hm.NewFnType(list, list, list)))
+
+	for _, op := range []string{"-", "*", "/"} {
+		numeric(op, same)
+	}
+
+	for _, op := range []string{"<", "<=", ">", ">="} {
+		numeric(op, boolean)
+		mod.AddOperator(op, mono(hm.NewFnType(str, str, bool_)))
+	}
+
+	for _, op := range []string{"==", "!="} {
+		mod.AddOperator(op, poly(hm.NewFnType(a, a, bool_)))
+	}
+
+	for _, op := range []string{"&&", "||"} {
+		mod.AddOperator(op, mono(hm.NewFnType(bool_, bool_, bool_)))
+	}
+
+	mod.AddOperator("!", mono(hm.NewFnType(bool_, bool_)))
+
+	return nil
+}
+
+// BinaryOp is an infix operator applied to two operands, like 1 + 2.
+type BinaryOp struct {
+	Op    string
+	Left  Node
+	Right Node
+	Loc   *SourceLocation
+}
+
+var _ Node = BinaryOp{}
+
+func (o BinaryOp) Body() hm.Expression { return o }
+
+func (o BinaryOp) GetSourceLocation() *SourceLocation { return o.Loc }
+
+func (o BinaryOp) Infer(env hm.Env, fresh hm.Fresher) (hm.Type, error) {
+	return WithInferErrorHandling(o, func() (hm.Type, error) {
+		var errs []error
+		lt, err := o.Left.Infer(env, fresh)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		rt, err := o.Right.Infer(env, fresh)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		if len(errs) > 0 {
+			return nil, errors.Join(errs...)
+		}
+		return inferOperator(env, fresh, "BinaryOp", o.Op, lt, rt)
+	})
+}
+
+// UnaryOp is a prefix operator applied to one operand, like !done.
+type UnaryOp struct {
+	Op      string
+	Operand Node
+	Loc     *SourceLocation
+}
+
+var _ Node = UnaryOp{}
+
+func (o UnaryOp) Body() hm.Expression { return o }
+
+func (o UnaryOp) GetSourceLocation() *SourceLocation { return o.Loc }
+
+func (o UnaryOp) Infer(env hm.Env, fresh hm.Fresher) (hm.Type, error) {
+	return WithInferErrorHandling(o, func() (hm.Type, error) {
+		t, err := o.Operand.Infer(env, fresh)
+		if err != nil {
+			return nil, err
+		}
+		return inferOperator(env, fresh, "UnaryOp", o.Op, t)
+	})
+}
+
+// inferOperator returns the result type of the first overload of op that
+// accepts the given operand types.
+func inferOperator(env hm.Env, fresh hm.Fresher, node, op string, operands ...hm.Type) (hm.Type, error) {
+	mod, ok := env.(*Module)
+	if !ok {
+		return nil, fmt.Errorf("%s.Infer: expected %T env, got %T", node, mod, env)
+	}
+
+	overloads := mod.OperatorsOf(op)
+	if len(overloads) == 0 {
+		return nil, fmt.Errorf("%s.Infer: unknown operator %s", node, op)
+	}
+
+	var candidates []string
+	for _, scheme := range overloads {
+		ft, ok := instantiate(fresh, scheme).(*hm.FunctionType)
+		if !ok {
+			return nil, fmt.Errorf("%s.Infer: operator %s is not a function: %s", node, op, scheme)
+		}
+		ret := ft.Ret(true)
+		call := hm.NewFnType(append(operands[:len(operands):len(operands)], ret)...)
+		// the first overload that applies is committed to
+		if err := unify(fresh, ft, call); err == nil {
+			return apply(fresh, ret), nil
+		}
+		t, _ := scheme.Type()
+		candidates = append(candidates, formatOverload(op, t.(*hm.FunctionType)))
+	}
+
+	operandTypes := make([]string, len(operands))
+	for i, t := range operands {
+		operandTypes[i] = t.String()
+	}
+	return nil, fmt.Errorf("%s.Infer: %s cannot be applied to %s; expected %s",
+		node, op, listJoin(operandTypes, ", ", "and"), listJoin(candidates, ", ", "or"))
+}
+
+// formatOverload renders an overload the way it would be written, e.g.
+// Int! + Int!.
+func formatOverload(op string, ft *hm.FunctionType) string {
+	var operands []string
+	var t hm.Type = ft
+	for {
+		fn, ok := t.(*hm.FunctionType)
+		if !ok {
+			break
+		}
+		operands = append(operands, fn.Arg().String())
+		t = fn.Ret(false)
+	}
+	if len(operands) == 1 {
+		return op + operands[0]
+	}
+	return strings.Join(operands, " "+op+" ")
+}
@@ -0,0 +1,154 @@
+package dash
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestOperatorPrecedence(t *testing.T) {
+	for _, c := range []struct {
+		Src    string
+		Parsed string
+	}{
+		{"a + b * c", "(a + (b * c))"},
+		{"a * b + c", "((a * b) + c)"},
+		{"a - b - c", "((a - b) - c)"},
+		{"a / b / c", "((a / b) / c)"},
+		{"a + b < c + d", "((a + b) < (c + d))"},
+		{"a < b == c > d", "((a < b) == (c > d))"},
+		{"a == b && c != d", "((a == b) && (c != d))"},
+		{"a && b || c && d", "((a && b) || (c && d))"},
+		{"a || b || c", "((a || b) || c)"},
+		{"!a && b", "((!a) && b)"},
+		{"!!a", "(!(!a))"},
+		{"a ? b || c", "(a ? (b || c))"},
+		{"a ? b ? c", "((a ? b) ? c)"},
+		{"(a + b) * c", "((a + b) * c)"},
+		{"a.b + c.d", "(a.b + c.d)"},
+	} {
+		parsed, err := Parse("test.dash", []byte(c.Src))
+		if err != nil {
+			t.Errorf("Parse(%q): %s", c.Src, err)
+			continue
+		}
+		forms := parsed.(Block).Forms
+		if len(forms) != 1 {
+			t.Errorf("Parse(%q): expected 1 form, got %d", c.Src, len(forms))
+			continue
+		}
+		if got := parenthesize(forms[0]); got != c.Parsed {
+			t.Errorf("Parse(%q) = %s, expected %s", c.Src, got, c.Parsed)
+		}
+	}
+}
+
+// parenthesize renders an expression with each operator application wrapped
+// in parentheses.
+func parenthesize(node Node) string {
+	switch n := node.(type) {
+	case BinaryOp:
+		return fmt.Sprintf("(%s %s %s)", parenthesize(n.Left), n.Op, parenthesize(n.Right))
+	case UnaryOp:
+		return fmt.Sprintf("(%s%s)", n.Op, parenthesize(n.Operand))
+	case Default:
+		return fmt.Sprintf("(%s ? %s)", parenthesize(n.Left), parenthesize(n.Right))
+	case Select:
+		return fmt.Sprintf("%s.%s", parenthesize(n.Receiver), n.Field)
+	case Symbol:
+		return n.Name
+	default:
+		return fmt.Sprintf("%#v", node)
+	}
+}
+
+func TestOperators(t *testing.T) {
+	runCheckCases(t, []checkCase{
+		{
+			Name: "integer arithmetic",
+			Src:  `pub x: Int! = 1 + 2 * 3 - 4 / 2`,
+		},
+		{
+			Name: "float arithmetic",
+			Src:  `pub x: Float! = 1.5 * 2.0`,
+		},
+		{
+			Name: "mixed arithmetic widens to Float",
+			Src:  `pub x: Float! = 1 + 2.5`,
+		},
+		{
+			Name: "mixed arithmetic is not an Int",
+			Src:  `pub x: Int! = 2.5 * 2`,
+			Err:  "Float ~ Int",
+		},
+		{
+			Name: "string concatenation",
+			Src:  `pub s: String! = "a" + "b"`,
+		},
+		{
+			Name: "list concatenation",
+			Src:  `pub l: [Int!]! = [1] + [2, 3]`,
+		},
+		{
+			Name: "list concatenation of mismatched lists",
+			Src:  `pub l = [1] + ["two"]`,
+			Err:  "+ cannot be applied to [Int!]! and [String!]!",
+		},
+		{
+			Name: "no overload",
+			Src:  `pub x = 1 + "two"`,
+			Err:  "+ cannot be applied to Int! and String!; expected Int! + Int!, Float! + Float!, Int! + Float!, Float! + Int!, String! + String! or [a]! + [a]!",
+		},
+		{
+			Name: "subtraction of strings",
+			Src:  `pub x = "a" - "b"`,
+			Err:  "- cannot be applied to String! and String!",
+		},
+		{
+			Name: "comparison",
+			Src:  `pub b: Boolean! = 1 < 2.5 && "a" >= "b"`,
+		},
+		{
+			Name: "comparison of booleans",
+			Src:  `pub b = true < false`,
+			Err:  "< cannot be applied to Boolean! and Boolean!",
+		},
+		{
+			Name: "equality",
+			Src:  `pub b: Boolean! = 1 == 2 || "a" != "b" || container == container`,
+		},
+		{
+			Name: "equality of mismatched types",
+			Src:  `pub b = 1 == "one"`,
+			Err:  "== cannot be applied to Int! and String!",
+		},
+		{
+			Name: "precedence determines the operand types",
+			Src:  `pub b: Boolean! = 1 + 2 < 3 * 4 == !false`,
+		},
+		{
+			Name: "boolean operators",
+			Src:  `pub b: Boolean! = !true && false || true`,
+		},
+		{
+			Name: "not of a non-boolean",
+			Src:  `pub b = !1`,
+			Err:  "! cannot be applied to Int!",
+		},
+		{
+			Name: "nullable operand",
+			Src: `pub maybe: Int = null
+pub x = maybe + 1`,
+			Err: "+ cannot be applied to Int and Int!",
+		},
+		{
+			Name: "nullable operand with a default",
+			Src: `pub maybe: Int = null
+pub x: Int! = (maybe ? 0) + 1`,
+		},
+		{
+			Name: "operator on an inferred argument",
+			Src: `pub double = fn(x) -> x * 2
+pub i: Int! = double(2)`,
+		},
+	})
+}
@@ -0,0 +1,361 @@
+package dash
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ParseOptions returns the options to pass to the generated parser so that
+// nodes know which file they came from, slots pick up their doc comments, and
+// syntax errors can suggest what was expected. Parse, ParseFile and
+// ParseReader pass them along already.
+func ParseOptions(filePath string) []Option {
+	return []Option{
+		GlobalStore("filePath", filePath),
+		exposeParser(),
+	}
+}
+
+// Parse parses the data from b, attributing each node to filename.
+func Parse(filename string, b []byte, opts ...Option) (any, error) {
+	return parse(filename, b, append(ParseOptions(filename), opts...)...)
+}
+
+// ParseFile parses the file identified by filename.
+func ParseFile(filename string, opts ...Option) (any, error) {
+	return parseFile(filename, append(ParseOptions(filename), opts...)...)
+}
+
+// ParseReader parses the data from r, attributing each node to filename.
+func ParseReader(filename string, r io.Reader, opts ...Option) (any, error) {
+	return parseReader(filename, r, append(ParseOptions(filename), opts...)...)
+}
+
+// SyntaxErrors converts the errors returned by the generated parser for source
+// into diagnostics.
+func SyntaxErrors(filePath string, source []byte, err error) Diagnostics {
+	list, ok := err.(errList)
+	if !ok {
+		return Diagnose(err)
+	}
+	var errs []error
+	for _, e := range list {
+		pe, ok := e.(*parserError)
+		if !ok {
+			errs = append(errs, e)
+			continue
+		}
+		var ie *InferError
+		if errors.As(pe.Inner, &ie) {
+			errs = append(errs, ie)
+			continue
+		}
+		line, col := sourcePosition(source, pe.pos.offset)
+		errs = append(errs, &InferError{
+			Err: pe.Inner,
+			Loc: &SourceLocation{
+				Filename: filePath,
+				Line:     line,
+				Column:   col,
+				Offset:   pe.pos.offset,
+				Length:   1,
+			},
+		})
+	}
+	return Diagnose(errors.Join(errs...))
+}
+
+// sourcePosition returns the 1-based line and column of a byte offset into
+// source. The generated parser counts a newline as column 0 of the line after
+// it, which would point past the end of the line it's on.
+func sourcePosition(source []byte, offset int) (line, col int) {
+	if offset > len(source) {
+		offset = len(source)
+	}
+	head := source[:offset]
+	line = bytes.Count(head, []byte{'\n'}) + 1
+	col = utf8.RuneCount(head[bytes.LastIndexByte(head, '\n')+1:]) + 1
+	return line, col
+}
+
+// exposeParser makes the parser available to grammar code, so that it can
+// consult the farthest failure when recovering from a syntax error.
+func exposeParser() Option {
+	return func(p *parser) Option {
+		old := p.cur.globalStore["parser"]
+		p.cur.globalStore["parser"] = p
+		return func(p *parser) Option {
+			p.cur.globalStore["parser"] = old
+			return exposeParser()
+		}
+	}
+}
+
+// Loc returns the location of the text matched by the current rule.
+func (c *current) Loc() *SourceLocation {
+	filename, _ := c.globalStore["filePath"].(string)
+	loc := &SourceLocation{
+		Filename: filename,
+		Length:   len(c.text),
+	}
+	c.locAt(loc, c.pos)
+	return loc
+}
+
+// locAt moves loc to a position found by the parser, e.g. its farthest
+// failure.
+func (c *current) locAt(loc *SourceLocation, pos position) {
+	loc.Line, loc.Column, loc.Offset = pos.line, pos.col, pos.offset
+	if p, ok := c.globalStore["parser"].(*parser); ok && pos.col == 0 {
+		// only newlines are miscounted
+		loc.Line, loc.Column = sourcePosition(p.data, pos.offset)
+	}
+}
+
+// TailLoc returns the location of the last n bytes of the text matched by the
+// current rule.
+func (c *current) TailLoc(n int) *SourceLocation {
+	return c.LocAt(len(c.text)-n, n)
+}
+
+// LocAt returns the location of n bytes of the text matched by the current
+// rule, starting at the given offset into it.
+func (c *current) LocAt(offset, n int) *SourceLocation {
+	loc := c.Loc()
+	head := c.text[:offset]
+	if nl := bytes.LastIndexByte(head, '\n'); nl != -1 {
+		loc.Line += bytes.Count(head, []byte{'\n'})
+		loc.Column = utf8.RuneCount(head[nl+1:]) + 1
+	} else {
+		loc.Column += utf8.RuneCount(head)
+	}
+	loc.Offset += offset
+	loc.Length = n
+	return loc
+}
+
+// DocComment returns the comment immediately preceding the text matched by the
+// current rule, without the leading #s, or "" if there is none. Comments only
+// document something that starts its own line, and a blank line between the
+// two detaches them.
+func (c *current) DocComment() string {
+	p, ok := c.globalStore["parser"].(*parser)
+	if !ok {
+		return ""
+	}
+	head := p.data[:c.pos.offset]
+	start := bytes.LastIndexByte(head, '\n') + 1
+	if len(bytes.TrimSpace(head[start:])) > 0 {
+		return ""
+	}
+	var lines []string
+	for start > 0 {
+		head = head[:start-1]
+		start = bytes.LastIndexByte(head, '\n') + 1
+		line := bytes.TrimSpace(head[start:])
+		if !bytes.HasPrefix(line, []byte("#")) {
+			break
+		}
+		lines = append(lines, strings.TrimPrefix(string(line[1:]), " "))
+	}
+	for i, j := 0, len(lines)-1; i < j; i, j = i+1, j-1 {
+		lines[i], lines[j] = lines[j], lines[i]
+	}
+	return strings.Join(lines, "\n")
+}
+
+// farthestFailure is a snapshot of the parser's farthest failure, taken right
+// before skipping over invalid input.
+type farthestFailure struct {
+	pos      position
+	expected []string
+}
+
+// MarkFailure records the parser's farthest failure so that SyntaxError can
+// report it. It must be called before consuming any of the invalid input, as
+// that may move the farthest failure along with it.
+func (c *current) MarkFailure() bool {
+	p, ok := c.globalStore["parser"].(*parser)
+	if !ok {
+		delete(c.globalStore, "failure")
+		return true
+	}
+	c.globalStore["failure"] = farthestFailure{
+		pos:      p.maxFailPos,
+		expected: append([]string(nil), p.maxFailExpected...),
+	}
+	return true
+}
+
+// SyntaxError returns an error for the invalid input matched by the current
+// rule, pointing at the farthest position the parser got to within it.
+func (c *current) SyntaxError() error {
+	loc := c.Loc()
+	rest := c.text
+
+	var expected []string
+	if failure, ok := c.globalStore["failure"].(farthestFailure); ok {
+		skip := failure.pos.offset - loc.Offset
+		switch {
+		case skip >= 0 && skip < len(c.text):
+			c.locAt(loc, failure.pos)
+			rest = c.text[skip:]
+			expected = describeExpected(failure.expected)
+		case skip >= len(c.text):
+			// the parser got past the invalid input into whatever we're resuming
+			// at, typically because something was left unclosed
+			if closer := unclosed(c.text); closer != "" {
+				loc = c.TailLoc(0)
+				rest = nil
+				expected = []string{closer}
+			} else if p, ok := c.globalStore["parser"].(*parser); ok &&
+				len(bytes.TrimSpace(p.data[loc.Offset+len(c.text):failure.pos.offset])) == 0 {
+				// only whitespace was left between the invalid input and what we're
+				// resuming at, e.g. two forms on one line
+				c.locAt(loc, failure.pos)
+				rest = p.data[failure.pos.offset:]
+				expected = describeExpected(failure.expected)
+			} else {
+				loc = c.TailLoc(0)
+				rest = nil
+			}
+		}
+	}
+
+	var unexpected string
+	if token := unexpectedToken(rest); token != "" {
+		unexpected = token
+		loc.Length = len(token)
+	} else if p, ok := c.globalStore["parser"].(*parser); ok && loc.Offset >= len(p.data) {
+		unexpected = "end of file"
+		loc.Length = 0
+	} else {
+		unexpected = "end of line"
+		loc.Length = 1
+	}
+
+	return &InferError{
+		Err: SyntaxError{
+			Unexpected: unexpected,
+			Expected:   expected,
+		},
+		Loc: loc,
+	}
+}
+
+// unexpectedToken returns the word or symbol at the start of text, if any.
+func unexpectedToken(text []byte) string {
+	r, w := utf8.DecodeRune(text)
+	switch {
+	case len(text) == 0 || unicode.IsSpace(r):
+		return ""
+	case unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_':
+		end := bytes.IndexFunc(text, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_'
+		})
+		if end == -1 {
+			end = len(text)
+		}
+		return string(text[:end])
+	default:
+		return string(text[:w])
+	}
+}
+
+// unclosed returns the closing bracket for the last bracket left open in text,
+// quoted like the parser's expectations.
+func unclosed(text []byte) string {
+	closers := map[byte]byte{'(': ')', '[': ']', '{': '}'}
+	var open []byte
+	var inString bool
+	for i := 0; i < len(text); i++ {
+		switch b := text[i]; {
+		case inString && b == '\\':
+			i++
+		case b == '"':
+			inString = !inString
+		case inString:
+		case closers[b] != 0:
+			open = append(open, closers[b])
+		case len(open) > 0 && b == open[len(open)-1]:
+			open = open[:len(open)-1]
+		}
+	}
+	if len(open) == 0 {
+		return ""
+	}
+	return strconv.Quote(string(open[len(open)-1]))
+}
+
+// whitespace is how the parser describes what the _ rule expects.
+var whitespace = map[string]bool{
+	`[ \t\r\n]`: true,
+	`[ \t\r]`:   true,
+	`"#"`:       true,
+}
+
+// friendlyExpected renames expected tokens that are hard to read.
+var friendlyExpected = map[string]string{
+	`"\n"`: "end of line",
+}
+
+// keywords may not be used as identifiers.
+var keywords = map[string]bool{
+	"pub":    true,
+	"pvt":    true,
+	"cls":    true,
+	"fn":     true,
+	"if":     true,
+	"else":   true,
+	"case":   true,
+	"import": true,
+	"true":   true,
+	"false":  true,
+	"null":   true,
+}
+
+func isKeyword(name string) bool {
+	return keywords[name]
+}
+
+// describeExpected cleans up the raw list of things the parser tried to match.
+func describeExpected(raw []string) []string {
+	seen := map[string]bool{}
+	var expected []string
+	for _, want := range raw {
+		// skip negative lookaheads, and whitespace and comments since they're
+		// allowed nearly everywhere
+		if strings.HasPrefix(want, "!") || whitespace[want] || seen[want] {
+			continue
+		}
+		seen[want] = true
+		if friendly, ok := friendlyExpected[want]; ok {
+			want = friendly
+		}
+		expected = append(expected, want)
+	}
+	sort.Strings(expected)
+	return expected
+}
+
+// SyntaxError is a part of the source that could not be parsed. The parser
+// skips over it and resumes at the next declaration or the end of the
+// enclosing block.
+type SyntaxError struct {
+	Unexpected string
+	Expected   []string
+}
+
+func (e SyntaxError) Error() string {
+	msg := "syntax error: unexpected " + e.Unexpected
+	if len(e.Expected) > 0 {
+		msg += ", expected " + listJoin(e.Expected, ", ", "or")
+	}
+	return msg
+}
@@ -0,0 +1,43 @@
+package dash
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParseEntryPoints(t *testing.T) {
+	src := []byte(`# The greeting.
+pub greeting = "hello"
+`)
+	path := filepath.Join(t.TempDir(), "main.dash")
+	if err := os.WriteFile(path, src, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, parse := range map[string]func() (any, error){
+		"Parse":     func() (any, error) { return Parse(path, src) },
+		"ParseFile": func() (any, error) { return ParseFile(path) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			parsed, err := parse()
+			if err != nil {
+				t.Fatal(err)
+			}
+			slot, ok := parsed.(Block).Forms[0].(SlotDecl)
+			if !ok {
+				t.Fatalf("expected SlotDecl, got %T", parsed.(Block).Forms[0])
+			}
+			if slot.Description != "The greeting." {
+				t.Errorf("expected description %q, got %q", "The greeting.", slot.Description)
+			}
+			loc := slot.GetSourceLocation()
+			if loc.Filename != path {
+				t.Errorf("expected filename %q, got %q", path, loc.Filename)
+			}
+			if loc.Line != 2 || loc.Column != 1 {
+				t.Errorf("expected 2:1, got %d:%d", loc.Line, loc.Column)
+			}
+		})
+	}
+}
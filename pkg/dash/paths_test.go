@@ -0,0 +1,62 @@
+package dash
+
+import "testing"
+
+func TestPaths(t *testing.T) {
+	runCheckCases(t, []checkCase{
+		{
+			Name: "file path",
+			Src:  `pub p: String! = ./main.go`,
+		},
+		{
+			Name: "directory path as a string",
+			Src:  `pub p: String! = ./src/`,
+		},
+		{
+			Name: "directory path as an argument",
+			Src:  `pub c = container.from(address: ./src/)`,
+		},
+		{
+			Name: "directory path in a list of strings",
+			Src:  `pub c = container.with-exec(["ls", ./src/])`,
+		},
+		{
+			Name: "directory path interpolated",
+			Src:  `pub s = "in ${./src/}"`,
+		},
+		{
+			Name: "join a file onto a directory",
+			Src: `pub dir = ./src/
+pub p: String! = dir/./main.go`,
+		},
+		{
+			Name: "join a directory onto a directory",
+			Src: `pub dir = ./src/
+pub sub = dir/./pkg/
+pub p: String! = sub/./main.go`,
+		},
+		{
+			Name: "join onto a file",
+			Src: `pub file = ./main.go
+pub p = file/./other.go`,
+			Err: "cannot join onto String!; expected a directory path",
+		},
+		{
+			Name: "join onto a joined file",
+			Src: `pub dir = ./src/
+pub file = dir/./main.go
+pub p = file/./other.go`,
+			Err: "cannot join onto String!; expected a directory path",
+		},
+		{
+			Name: "join onto a string",
+			Src:  `pub f(dir: String!): String! { dir/./main.go }`,
+			Err:  "cannot join onto String!; expected a directory path",
+		},
+		{
+			Name: "string as a directory path",
+			Src:  `pub p: DirPath! = "src"`,
+			Err:  "DirPath",
+		},
+	})
+}
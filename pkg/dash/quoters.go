@@ -0,0 +1,136 @@
+package dash
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/chewxy/hm"
+	"gopkg.in/yaml.v3"
+)
+
+// Quoter interprets the raw contents of a %name{...} literal, returning the
+// node it stands for. The node determines both the literal's type and its
+// value.
+type Quoter func(raw string, loc *SourceLocation) (Node, error)
+
+// Quoters is the registry of quoters available to %name{...} literals.
+var Quoters = map[string]Quoter{}
+
+// RegisterQuoter makes a quoter available as %name{...}.
+func RegisterQuoter(name string, quoter Quoter) {
+	Quoters[name] = quoter
+}
+
+func init() {
+	RegisterQuoter("w", quoteWords)
+	RegisterQuoter("s", quoteHeredoc)
+	RegisterQuoter("json", quoteJSON)
+	RegisterQuoter("yaml", quoteYAML)
+}
+
+var _ Node = Quoted{}
+
+func (q Quoted) Body() hm.Expression { return q }
+
+func (q Quoted) GetSourceLocation() *SourceLocation { return q.Loc }
+
+func (q Quoted) Infer(env hm.Env, fresh hm.Fresher) (hm.Type, error) {
+	return WithInferErrorHandling(q, func() (hm.Type, error) {
+		node, err := q.Unquote()
+		if err != nil {
+			return nil, err
+		}
+		return node.Infer(env, fresh)
+	})
+}
+
+// Unquote returns the node that the literal stands for.
+func (q Quoted) Unquote() (Node, error) {
+	quoter, found := Quoters[q.Quoter]
+	if !found {
+		known := make([]string, 0, len(Quoters))
+		for name := range Quoters {
+			known = append(known, "%"+name)
+		}
+		sort.Strings(known)
+		return nil, fmt.Errorf("Quoted.Infer: unknown quoter %%%s; known quoters: %s", q.Quoter, strings.Join(known, ", "))
+	}
+	node, err := quoter(q.Raw, q.Loc)
+	if err != nil {
+		return nil, fmt.Errorf("Quoted.Infer: %%%s: %w", q.Quoter, err)
+	}
+	return node, nil
+}
+
+// quoteWords splits the contents on whitespace into a list of strings, e.g.
+// %w{a b c} is ["a", "b", "c"].
+func quoteWords(raw string, loc *SourceLocation) (Node, error) {
+	words := strings.Fields(raw)
+	elems := make([]Node, len(words))
+	for i, word := range words {
+		elems[i] = String{word, loc}
+	}
+	return List{Elements: elems, Loc: loc}, nil
+}
+
+// quoteHeredoc returns the contents as a string, verbatim except for the
+// indentation common to all lines and a leading newline, so that multiline
+// strings can be indented along with the code around them.
+func quoteHeredoc(raw string, loc *SourceLocation) (Node, error) {
+	return String{dedent(raw), loc}, nil
+}
+
+// quoteJSON returns the contents as a string, after making sure it's valid.
+func quoteJSON(raw string, loc *SourceLocation) (Node, error) {
+	doc := dedent(raw)
+	var val any
+	if err := json.Unmarshal([]byte(doc), &val); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %w", err)
+	}
+	return String{doc, loc}, nil
+}
+
+// quoteYAML returns the contents as a string, after making sure it's valid.
+func quoteYAML(raw string, loc *SourceLocation) (Node, error) {
+	doc := dedent(raw)
+	var val any
+	if err := yaml.Unmarshal([]byte(doc), &val); err != nil {
+		return nil, fmt.Errorf("invalid YAML: %w", err)
+	}
+	return String{doc, loc}, nil
+}
+
+// dedent strips a leading newline and any indentation shared by all non-blank
+// lines, along with the trailing indentation before the closing brace.
+func dedent(raw string) string {
+	raw = strings.TrimPrefix(raw, "\n")
+	lines := strings.Split(raw, "\n")
+	if last := lines[len(lines)-1]; strings.TrimSpace(last) == "" {
+		lines[len(lines)-1] = ""
+	}
+
+	prefix := ""
+	first := true
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		indent := line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+		if first {
+			prefix = indent
+			first = false
+			continue
+		}
+		for !strings.HasPrefix(indent, prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+
+	for i, line := range lines {
+		lines[i] = strings.TrimPrefix(line, prefix)
+	}
+
+	return strings.Join(lines, "\n")
+}
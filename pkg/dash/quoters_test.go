@@ -0,0 +1,95 @@
+package dash
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestQuoters(t *testing.T) {
+	RegisterQuoter("test-int", func(raw string, loc *SourceLocation) (Node, error) {
+		i, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		return Int{i, loc}, nil
+	})
+	defer delete(Quoters, "test-int")
+
+	runCheckCases(t, []checkCase{
+		{
+			Name: "words",
+			Src:  `pub c = container.with-exec(%w{echo hello world})`,
+		},
+		{
+			Name: "words are a list of strings",
+			Src:  `pub ws: [String!]! = %w{a b c}`,
+		},
+		{
+			Name: "heredoc",
+			Src: `pub s: String! = %s{
+  echo hi
+}`,
+		},
+		{
+			Name: "nested braces",
+			Src:  `pub s: String! = %s{ {a: {b: c}} }`,
+		},
+		{
+			Name: "json",
+			Src:  `pub s: String! = %json{{"a": [1, 2]}}`,
+		},
+		{
+			Name: "invalid json",
+			Src:  `pub s = %json{{"a": }}`,
+			Err:  "%json: invalid JSON",
+		},
+		{
+			Name: "yaml",
+			Src: `pub s: String! = %yaml{
+  a: [1, 2]
+  b: c
+}`,
+		},
+		{
+			Name: "invalid yaml",
+			Src:  `pub s = %yaml{a: [}`,
+			Err:  "%yaml: invalid YAML",
+		},
+		{
+			Name: "unknown quoter",
+			Src:  `pub s = %nope{a}`,
+			Err:  "unknown quoter %nope; known quoters: %json, %s, %test-int, %w, %yaml",
+		},
+		{
+			Name: "registered quoter",
+			Src:  `pub i: Int! = %test-int{42}`,
+		},
+		{
+			Name: "registered quoter determines the type",
+			Src:  `pub s: String! = %test-int{42}`,
+			Err:  "Int ~ String",
+		},
+		{
+			Name: "registered quoter error",
+			Src:  `pub i = %test-int{x}`,
+			Err:  "%test-int: strconv.ParseInt",
+		},
+	})
+}
+
+func TestDedent(t *testing.T) {
+	for _, c := range []struct {
+		Raw  string
+		Text string
+	}{
+		{"abc", "abc"},
+		{"\n  a\n  b\n", "a\nb\n"},
+		{"\n  a\n    b\n  ", "a\n  b\n"},
+		{"\n    a\n\n  b\n", "  a\n\nb\n"},
+		{"\n\ta\n\t\tb\n", "a\n\tb\n"},
+	} {
+		if got := dedent(c.Raw); got != c.Text {
+			t.Errorf("dedent(%q) = %q, expected %q", c.Raw, got, c.Text)
+		}
+	}
+}
@@ -1,6 +1,12 @@
 package dash
 
-import "github.com/chewxy/hm"
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/chewxy/hm"
+)
 
 type Record []Keyed[Node]
 
@@ -21,3 +27,124 @@ func (r Record) Infer(env hm.Env, fresh hm.Fresher) (hm.Type, error) {
 var _ hm.Expression = Record{}
 
 func (r Record) Body() hm.Expression { return r }
+
+// Object is a record literal.
+type Object struct {
+	Fields Record
+	Input  *RecordType // the input object it is passed as, if any
+	Loc    *SourceLocation
+}
+
+var _ Node = Object{}
+
+func (o Object) Body() hm.Expression { return o.Fields }
+
+func (o Object) GetSourceLocation() *SourceLocation { return o.Loc }
+
+func (o Object) Infer(env hm.Env, fresh hm.Fresher) (hm.Type, error) {
+	return WithInferErrorHandling(o, func() (hm.Type, error) {
+		if o.Input != nil {
+			return o.inferInput(env, fresh)
+		}
+		var errs []error
+		seen := map[string]bool{}
+		fields := make([]Keyed[*hm.Scheme], 0, len(o.Fields))
+		for _, f := range o.Fields {
+			if seen[f.Key] {
+				errs = append(errs, NewInferError(fmt.Errorf("Object.Infer: duplicate field %q", f.Key), f.Value))
+				continue
+			}
+			seen[f.Key] = true
+			t, err := f.Value.Infer(env, fresh)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			fields = append(fields, Keyed[*hm.Scheme]{f.Key, hm.NewScheme(nil, t)})
+		}
+		if len(errs) > 0 {
+			return nil, errors.Join(errs...)
+		}
+		return NonNullType{NewRecordType("", fields...)}, nil
+	})
+}
+
+// inferInput checks the fields of the object against the input object it is
+// passed as, reporting every bad field at once.
+func (o Object) inferInput(env hm.Env, fresh hm.Fresher) (hm.Type, error) {
+	var errs []error
+	seen := map[string]bool{}
+	for _, f := range o.Fields {
+		if seen[f.Key] {
+			errs = append(errs, NewInferError(fmt.Errorf("Object.Infer: duplicate field %q", f.Key), f.Value))
+			continue
+		}
+		seen[f.Key] = true
+
+		scheme, found := o.Input.SchemeOf(f.Key)
+		if !found {
+			errs = append(errs, NewInferError(fmt.Errorf("Object.Infer: %q is not a field of %s%s", f.Key, o.Input.Named, didYouMean(f.Key, hasScheme(o.Input))), f.Value))
+			continue
+		}
+		dt, _ := scheme.Type()
+
+		v := expectType(env, f.Value, dt)
+		it, err := v.Infer(env, fresh)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		it = widen(dt, it)
+
+		if err := unify(fresh, dt, it); err != nil {
+			doc, _ := o.Input.DocOf(f.Key)
+			errs = append(errs, withDoc(NewInferError(fmt.Errorf("Object.Infer: field %q cannot unify (%s ~ %s): %w", f.Key, dt, it, err), v), f.Key, doc))
+		}
+	}
+
+	var missing []string
+	for _, f := range o.Input.Fields {
+		if !seen[f.Key] && !o.Input.Optional(f.Key) {
+			missing = append(missing, fmt.Sprintf("%q", f.Key))
+		}
+	}
+	if len(missing) > 0 {
+		noun := "field"
+		if len(missing) > 1 {
+			noun += "s"
+		}
+		errs = append(errs, NewInferError(fmt.Errorf("Object.Infer: %s is missing required %s %s", o.Input.Named, noun, listJoin(missing, ", ", "and")), o))
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return NonNullType{o.Input}, nil
+}
+
+// recordMethods are the fields available on every record, unless the record
+// has a field of the same name. They serialize the record, e.g. to write a
+// config file.
+var recordMethods = map[string]TypeNode{
+	"json": NonNullTypeNode{NamedTypeNode{"String", nil}, nil},
+	"yaml": NonNullTypeNode{NamedTypeNode{"String", nil}, nil},
+	"toml": NonNullTypeNode{NamedTypeNode{"String", nil}, nil},
+}
+
+func (d Select) inferRecord(env hm.Env, fresh hm.Fresher, rt *RecordType) (hm.Type, error) {
+	if scheme, found := rt.SchemeOf(d.Field); found {
+		return instantiate(fresh, scheme), nil
+	}
+	if method, found := recordMethods[d.Field]; found {
+		return method.Infer(env, fresh)
+	}
+	fields := make([]string, 0, len(rt.Fields)+len(recordMethods))
+	for _, f := range rt.Fields {
+		fields = append(fields, f.Key)
+	}
+	for name := range recordMethods {
+		fields = append(fields, name)
+	}
+	sort.Strings(fields[len(rt.Fields):])
+	return nil, fmt.Errorf("Select.Infer: field %q not found in record %s; available: %s", d.Field, rt, listJoin(fields, ", ", "and"))
+}
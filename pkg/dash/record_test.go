@@ -0,0 +1,90 @@
+package dash
+
+import "testing"
+
+func TestRecords(t *testing.T) {
+	runCheckCases(t, []checkCase{
+		{
+			Name: "select a field",
+			Src: `pub r = {name: "dash", version: 1}
+pub n: String! = r.name
+pub v: Int! = r.version`,
+		},
+		{
+			Name: "select a field of the wrong type",
+			Src: `pub r = {name: "dash"}
+pub n: Int! = r.name`,
+			Err: "String ~ Int",
+		},
+		{
+			Name: "unknown field",
+			Src: `pub r = {name: "dash"}
+pub x = r.nme`,
+			Err: `field "nme" not found in record {name: String!}; available: name, json, toml and yaml`,
+		},
+		{
+			Name: "nested records",
+			Src: `pub r = {a: {b: {c: 1}}}
+pub c: Int! = r.a.b.c`,
+		},
+		{
+			Name: "fields on separate lines",
+			Src: `pub r = {
+  name: "dash"
+  tags: ["a", "b"]
+}
+pub tags: [String!]! = r.tags`,
+		},
+		{
+			Name: "duplicate field",
+			Src:  `pub r = {a: 1, a: 2}`,
+			Err:  `duplicate field "a"`,
+		},
+		{
+			Name: "field with an error",
+			Src:  `pub r = {a: 1 + "b"}`,
+			Err:  "+ cannot be applied to Int! and String!",
+		},
+		{
+			Name: "json",
+			Src:  `pub s: String! = {a: 1}.json`,
+		},
+		{
+			Name: "yaml",
+			Src: `pub config = {
+  cmd: ["echo", "hi"]
+  environment: {PATH: "/bin"}
+}.yaml
+pub c = container.from("alpine").with-env-variable(name: "CONFIG", value: config)`,
+		},
+		{
+			Name: "toml",
+			Src:  `pub s: String! = {a: 1}.toml`,
+		},
+		{
+			Name: "field shadows a method",
+			Src: `pub r = {json: 1}
+pub j: Int! = r.json`,
+		},
+		{
+			Name: "record through an inferred function",
+			Src: `pub id = fn(x) -> x
+pub n: String! = id({name: "dash"}).name`,
+		},
+		{
+			Name: "records unify by field",
+			Src: `pub r = if true { {a: 1} } else { {a: 2} }
+pub a: Int! = r.a`,
+		},
+		{
+			Name: "records with mismatched fields",
+			Src:  `pub r = if true { {a: 1} } else { {a: "two"} }`,
+			Err:  "branches have mismatched types",
+		},
+		{
+			Name: "record of an inferred argument",
+			Src: `pub wrap = fn(x) -> {value: x}
+pub v: Int! = wrap(1).value`,
+		},
+	})
+}
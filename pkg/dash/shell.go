@@ -0,0 +1,98 @@
+package dash
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ShellWord is a word of a shell command, along with its byte offset into the
+// command.
+type ShellWord struct {
+	Value  string
+	Offset int
+	Length int
+}
+
+// ShellWords splits a command into words the way Bash would, minus all the
+// expansions: words are separated by unquoted whitespace, single quotes
+// preserve everything literally, and double quotes and backslashes escape
+// characters. Variables like $PATH are left as-is, to be expanded by whatever
+// runs the command (if anything).
+func ShellWords(cmd string) ([]ShellWord, error) {
+	var words []ShellWord
+
+	var word strings.Builder
+	inWord := false
+	start := 0
+
+	flush := func(end int) {
+		if inWord {
+			words = append(words, ShellWord{
+				Value:  word.String(),
+				Offset: start,
+				Length: end - start,
+			})
+		}
+		word.Reset()
+		inWord = false
+	}
+
+	begin := func(i int) {
+		if !inWord {
+			inWord = true
+			start = i
+		}
+	}
+
+	for i := 0; i < len(cmd); i++ {
+		switch ch := cmd[i]; ch {
+		case ' ', '\t', '\r', '\n':
+			flush(i)
+		case '\\':
+			if i+1 == len(cmd) {
+				return nil, fmt.Errorf("trailing backslash")
+			}
+			i++
+			if cmd[i] == '\n' {
+				// a backslash-newline is a line continuation, which doesn't begin a
+				// word on its own
+				continue
+			}
+			begin(i - 1)
+			word.WriteByte(cmd[i])
+		case '\'':
+			begin(i)
+			end := strings.IndexByte(cmd[i+1:], '\'')
+			if end == -1 {
+				return nil, fmt.Errorf("unterminated single quote")
+			}
+			word.WriteString(cmd[i+1 : i+1+end])
+			i += end + 1
+		case '"':
+			begin(i)
+			i++
+			for ; i < len(cmd) && cmd[i] != '"'; i++ {
+				if cmd[i] == '\\' && i+1 < len(cmd) {
+					switch cmd[i+1] {
+					case '"', '\\', '$', '`':
+						i++
+					case '\n':
+						i++
+						continue
+					}
+				}
+				word.WriteByte(cmd[i])
+			}
+			if i == len(cmd) {
+				return nil, fmt.Errorf("unterminated double quote")
+			}
+		default:
+			begin(i)
+			word.WriteByte(ch)
+		}
+	}
+
+	flush(len(cmd))
+
+	return words, nil
+}
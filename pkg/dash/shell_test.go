@@ -0,0 +1,101 @@
+package dash
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestShellWords(t *testing.T) {
+	for _, c := range []struct {
+		Cmd   string
+		Words []string
+		Err   string
+	}{
+		{Cmd: "echo hello, world!", Words: []string{"echo", "hello,", "world!"}},
+		{Cmd: "  echo \t hi \n", Words: []string{"echo", "hi"}},
+		{Cmd: "echo 'a b' \"c d\"", Words: []string{"echo", "a b", "c d"}},
+		{Cmd: "echo 'a \\ \"b\"'", Words: []string{"echo", "a \\ \"b\""}},
+		{Cmd: `echo "a \"b\" \$c \\ \d"`, Words: []string{"echo", `a "b" $c \ \d`}},
+		{Cmd: `echo a\ b \'c`, Words: []string{"echo", "a b", "'c"}},
+		{Cmd: "echo a'b'\"c\"d", Words: []string{"echo", "abcd"}},
+		{Cmd: "echo a \\\n  b", Words: []string{"echo", "a", "b"}},
+		{Cmd: "echo a\\\nb", Words: []string{"echo", "ab"}},
+		{Cmd: "echo \"a\\\nb\"", Words: []string{"echo", "ab"}},
+		{Cmd: "echo '' \"\"", Words: []string{"echo", "", ""}},
+		{Cmd: "echo $PATH", Words: []string{"echo", "$PATH"}},
+		{Cmd: "echo a\\", Err: "trailing backslash"},
+		{Cmd: "echo 'a", Err: "unterminated single quote"},
+		{Cmd: "echo \"a", Err: "unterminated double quote"},
+	} {
+		words, err := ShellWords(c.Cmd)
+		if c.Err != "" {
+			if err == nil || err.Error() != c.Err {
+				t.Errorf("ShellWords(%q): expected error %q, got %v", c.Cmd, c.Err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ShellWords(%q): %s", c.Cmd, err)
+			continue
+		}
+		values := make([]string, len(words))
+		for i, word := range words {
+			values[i] = word.Value
+		}
+		if !reflect.DeepEqual(values, c.Words) {
+			t.Errorf("ShellWords(%q) = %q, expected %q", c.Cmd, values, c.Words)
+		}
+	}
+}
+
+func TestShellWordOffsets(t *testing.T) {
+	words, err := ShellWords(`echo  'a b'  "c"d`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []ShellWord{
+		{Value: "echo", Offset: 0, Length: 4},
+		{Value: "a b", Offset: 6, Length: 5},
+		{Value: "cd", Offset: 13, Length: 4},
+	}
+	if !reflect.DeepEqual(words, expected) {
+		t.Errorf("ShellWords = %+v, expected %+v", words, expected)
+	}
+}
+
+func TestExec(t *testing.T) {
+	runCheckCases(t, []checkCase{
+		{
+			Name: "command",
+			Src:  `pub c = container.from("alpine") $ echo hello, world!;`,
+		},
+		{
+			Name: "quoted arguments",
+			Src:  `pub c = container.from("alpine") $ sh -c 'echo "$HOME"; exit 1' "a;b";`,
+		},
+		{
+			Name: "command on the next line",
+			Src: `pub c = container.from("alpine")
+  $ echo hi;`,
+		},
+		{
+			Name: "chained commands",
+			Src:  `pub s: String! = container.from("alpine") $ echo a; $ echo b;.stdout`,
+		},
+		{
+			Name: "unterminated quote",
+			Src:  `pub c = container.from("alpine") $ echo 'hi;`,
+			Err:  "syntax error",
+		},
+		{
+			Name: "escaped semicolon",
+			Src:  `pub c = container.from("alpine") $ echo \;`,
+			Err:  "syntax error",
+		},
+		{
+			Name: "receiver without with-exec",
+			Src:  `pub c = "alpine" $ echo hi;`,
+			Err:  `field "with-exec" not found in record String`,
+		},
+	})
+}
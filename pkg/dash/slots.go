@@ -7,10 +7,12 @@ import (
 )
 
 type SlotDecl struct {
-	Named      string
-	Type_      TypeNode
-	Value      Node
-	Visibility Visibility
+	Named       string
+	Type_       TypeNode
+	Value       Node
+	Visibility  Visibility
+	Description string // from the comment preceding it
+	Loc         *SourceLocation
 }
 
 var _ Node = SlotDecl{}
@@ -20,6 +22,8 @@ func (s SlotDecl) Body() hm.Expression {
 	return s
 }
 
+func (s SlotDecl) GetSourceLocation() *SourceLocation { return s.Loc }
+
 var _ Hoister = SlotDecl{}
 
 func (c SlotDecl) Hoist(env hm.Env, fresh hm.Fresher, depth int) error {
@@ -31,16 +35,47 @@ func (c SlotDecl) Hoist(env hm.Env, fresh hm.Fresher, depth int) error {
 	if c.Type_ != nil {
 		dt, err := c.Type_.Infer(env, fresh)
 		if err != nil {
-			return fmt.Errorf("SlotDecl.Hoist: Infer %T: %w", c.Type_, err)
+			env.Add(c.Named, hm.NewScheme(nil, errorType{}))
+			return NewInferError(fmt.Errorf("SlotDecl.Hoist: Infer %T: %w", c.Type_, err), c)
 		}
 
-		env.Add(c.Named, hm.NewScheme(nil, dt))
+		env.Add(c.Named, generalize(env, fresh, dt))
+		addDoc(env, c.Named, c.Description)
+		c.addRequired(env, dt)
 	}
 
 	return nil
 }
 
+// addRequired marks the slot as required if it has no value to fall back on.
+func (s SlotDecl) addRequired(env hm.Env, dt hm.Type) {
+	if s.Value != nil {
+		return
+	}
+	if _, nonNull := dt.(NonNullType); !nonNull {
+		return
+	}
+	if mod, ok := env.(*Module); ok {
+		mod.AddRequired(s.Named)
+	}
+}
+
 func (s SlotDecl) Infer(env hm.Env, fresh hm.Fresher) (hm.Type, error) {
+	return WithInferErrorHandling(s, func() (hm.Type, error) {
+		t, err := s.infer(env, fresh)
+		if err != nil {
+			// if the slot wasn't hoisted, bind it anyway so that references to it
+			// don't report more errors
+			if _, defined := env.(*Module).LocalSchemeOf(s.Named); !defined {
+				env.Add(s.Named, hm.NewScheme(nil, errorType{}))
+			}
+			return nil, err
+		}
+		return t, nil
+	})
+}
+
+func (s SlotDecl) infer(env hm.Env, fresh hm.Fresher) (hm.Type, error) {
 	var err error
 
 	var definedType hm.Type
@@ -53,15 +88,19 @@ func (s SlotDecl) Infer(env hm.Env, fresh hm.Fresher) (hm.Type, error) {
 
 	var inferredType hm.Type
 	if s.Value != nil {
+		if definedType != nil {
+			s.Value = expectType(env, s.Value, definedType)
+		}
 		inferredType, err = s.Value.Infer(env, fresh)
 		if err != nil {
 			return nil, err
 		}
 
 		if definedType != nil {
-			_, err = hm.Unify(inferredType, definedType)
+			inferredType = widen(definedType, inferredType)
+			err = unify(fresh, inferredType, definedType)
 			if err != nil {
-				return nil, fmt.Errorf("SlotDecl.Infer: Unify %T(%s) ~ %T(%s): %s", inferredType, inferredType, definedType, definedType, err)
+				return nil, withDoc(NewInferError(fmt.Errorf("SlotDecl.Infer: Unify %T(%s) ~ %T(%s): %s", inferredType, inferredType, definedType, definedType, err), s.Value), s.Named, s.Description)
 			}
 		} else {
 			definedType = inferredType
@@ -72,26 +111,22 @@ func (s SlotDecl) Infer(env hm.Env, fresh hm.Fresher) (hm.Type, error) {
 		return nil, fmt.Errorf("SlotDecl.Infer: no type or value")
 	}
 
-	// definedType = definedType.Apply(subs)
-
-	// if !definedType.Eq(inferredType) {
-	// 	return nil, fmt.Errorf("SlotDecl.Infer: %q mismatch: defined as %s, inferred as %s", s.Named, definedType, inferredType)
-	// }
+	definedType = apply(fresh, definedType)
 
 	cur, defined := env.SchemeOf(s.Named)
 	if defined {
-		curT, curMono := cur.Type()
-		if !curMono {
-			return nil, fmt.Errorf("SlotDecl.Infer: TODO: type is not monomorphic")
-		}
-
+		// a hoisted signature is generalized the same way, so their variables
+		// match
+		curT, _ := cur.Type()
 		if !definedType.Eq(curT) {
 			return nil, fmt.Errorf("SlotDecl.Infer: %q already defined as %s", s.Named, curT)
 		}
 	}
 
 	if definedType != nil {
-		env.Add(s.Named, hm.NewScheme(nil, definedType))
+		env.Add(s.Named, generalize(env, fresh, definedType))
+		addDoc(env, s.Named, s.Description)
+		s.addRequired(env, definedType)
 		return definedType, nil
 	} else {
 		return nil, fmt.Errorf("SlotDecl.Infer: no type or value")
@@ -99,15 +134,19 @@ func (s SlotDecl) Infer(env hm.Env, fresh hm.Fresher) (hm.Type, error) {
 }
 
 type ClassDecl struct {
-	Named      string
-	Value      Block
-	Visibility Visibility // theoretically the type itself is public but its constructor value can be private
+	Named       string
+	Value       Block
+	Visibility  Visibility // theoretically the type itself is public but its constructor value can be private
+	Description string     // from the comment preceding it
+	Loc         *SourceLocation
 }
 
 var _ Node = ClassDecl{}
 
 func (c ClassDecl) Body() hm.Expression { return c.Value }
 
+func (c ClassDecl) GetSourceLocation() *SourceLocation { return c.Loc }
+
 var _ Hoister = ClassDecl{}
 
 func (c ClassDecl) Hoist(env hm.Env, fresh hm.Fresher, depth int) error {
@@ -118,11 +157,13 @@ func (c ClassDecl) Hoist(env hm.Env, fresh hm.Fresher, depth int) error {
 		class = NewModule(c.Named)
 		mod.AddClass(class)
 	}
+	class.Description = c.Description
 
 	// set special 'self' keyword to match the function signature.
 	self := hm.NewScheme(nil, class)
 	class.Add("self", self)
 	env.Add(c.Named, self)
+	addDoc(env, c.Named, c.Description)
 
 	// TODO: hacky, see elsewhere
 	class.Parent = mod
@@ -140,6 +181,12 @@ func (c ClassDecl) Hoist(env hm.Env, fresh hm.Fresher, depth int) error {
 }
 
 func (c ClassDecl) Infer(env hm.Env, fresh hm.Fresher) (hm.Type, error) {
+	return WithInferErrorHandling(c, func() (hm.Type, error) {
+		return c.infer(env, fresh)
+	})
+}
+
+func (c ClassDecl) infer(env hm.Env, fresh hm.Fresher) (hm.Type, error) {
 	mod := env.(*Module)
 
 	class, found := mod.NamedType(c.Named)
@@ -155,10 +202,9 @@ func (c ClassDecl) Infer(env hm.Env, fresh hm.Fresher) (hm.Type, error) {
 	// class to actually recurse to the original context.
 	class.Parent = mod
 
+	// errors are returned only after the class is fully bound, so that uses of
+	// it elsewhere still check against whatever part of it was valid
 	_, err := c.Value.Infer(class, fresh)
-	if err != nil {
-		return nil, err
-	}
 
 	class.Parent = nil
 
@@ -166,6 +212,18 @@ func (c ClassDecl) Infer(env hm.Env, fresh hm.Fresher) (hm.Type, error) {
 	self := hm.NewScheme(nil, class)
 	class.Add("self", self)
 	env.Add(c.Named, self)
+	addDoc(env, c.Named, c.Description)
+
+	if err != nil {
+		return nil, err
+	}
 
 	return class, nil
 }
+
+// addDoc records the description of a slot in the module it is bound in.
+func addDoc(env hm.Env, name, doc string) {
+	if mod, ok := env.(*Module); ok && doc != "" {
+		mod.AddDoc(name, doc)
+	}
+}
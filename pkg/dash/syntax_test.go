@@ -0,0 +1,87 @@
+package dash
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSyntaxErrorLocations(t *testing.T) {
+	runCheckCases(t, []checkCase{
+		{
+			Name: "unterminated string",
+			Src:  "pub x = \"abc\n",
+			Err:  "main.dash:1:13: syntax error: unexpected end of line",
+		},
+		{
+			Name: "unterminated interpolation",
+			Src:  "pub x = 1\npub y = \"${",
+			Err:  "main.dash:2:12: syntax error: unexpected end of file",
+		},
+		{
+			Name: "unclosed paren",
+			Src:  "pub x = (1\n",
+			Err:  "main.dash:2:1: syntax error: unexpected end of file, expected \")\"",
+		},
+		{
+			Name: "unexpected token",
+			Src:  "pub x = 1\n)",
+			Err:  "main.dash:2:1: syntax error: unexpected )",
+		},
+	})
+}
+
+func TestSyntaxErrorRecovery(t *testing.T) {
+	for _, c := range []struct {
+		Name   string
+		Src    string
+		Errors int
+	}{
+		{
+			Name:   "brace in a string",
+			Src:    "pub a = ) \"x}y\"\npub b = 1",
+			Errors: 1,
+		},
+		{
+			Name:   "brace in a comment",
+			Src:    "pub a = ) # }\npub b = 1",
+			Errors: 1,
+		},
+		{
+			Name:   "balanced braces",
+			Src:    "pub a = ) { 1 }\npub b = 1",
+			Errors: 1,
+		},
+		{
+			Name:   "keyword in a string",
+			Src:    "pub a = ) \"x.pub\" pub\npub b = )",
+			Errors: 2,
+		},
+		{
+			Name:   "keyword in a word",
+			Src:    "pub a = ) public\npub b = )",
+			Errors: 2,
+		},
+		{
+			Name:   "end of a block",
+			Src:    "pub f(): Int! {\n  )\n}\npub b = )",
+			Errors: 2,
+		},
+	} {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "main.dash")
+			if err := os.WriteFile(path, []byte(c.Src), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			err := CheckFile(testSchema(), path)
+			if err == nil {
+				t.Fatalf("expected %d errors, got none", c.Errors)
+			}
+			if n := strings.Count(err.Error(), "syntax error"); n != c.Errors {
+				t.Errorf("expected %d errors, got %d:\n%s", c.Errors, n, err)
+			}
+		})
+	}
+}
@@ -24,10 +24,27 @@ func TreesitterGrammar() treesitter.Grammar {
 	}
 	ts.Supertypes = []string{"expr"}
 
+	// order matters; see src/scanner.c
+	ts.Externals = []treesitter.Rule{
+		{
+			Type: treesitter.RuleTypeSymbol,
+			Name: treesitter.Name("EolToken"),
+		},
+		{
+			Type: treesitter.RuleTypeSymbol,
+			Name: treesitter.Name("ElseSeparator"),
+		},
+		{
+			// only valid during error recovery
+			Type: treesitter.RuleTypeSymbol,
+			Name: "error_sentinel",
+		},
+	}
+
 	for i, rule := range g.rules {
 		prec := len(g.rules) - i
 		tsRule := treesitterRule(rule, prec)
-		if tsRule == nil || rule.name == "_" {
+		if tsRule == nil || treesitterIgnored[rule.name] || treesitterExternal[rule.name] {
 			log.Println("skipping rule", rule.name)
 			continue
 		} else {
@@ -39,6 +56,31 @@ func TreesitterGrammar() treesitter.Grammar {
 	return ts
 }
 
+// treesitterIgnored is the set of rules that only make sense for the PEG
+// parser.
+var treesitterIgnored = map[string]bool{
+	// whitespace; tree-sitter works differently
+	"_":  true,
+	"__": true,
+
+	// error recovery; tree-sitter has its own
+	"Recover": true,
+	"Resync":  true,
+	"Skipped": true,
+}
+
+// treesitterExternal is the set of rules that are matched by the external
+// scanner in src/scanner.c instead.
+var treesitterExternal = map[string]bool{
+	// whether a newline terminates a form depends on the next line, which
+	// tree-sitter's lexer can't look at
+	"EolToken": true,
+
+	// an else on the next line continues a conditional, but may also begin the
+	// next arm of a case
+	"ElseSeparator": true,
+}
+
 func treesitterRule(r *rule, prec int) *treesitter.Rule {
 	ts := &treesitter.Rule{}
 
@@ -71,15 +113,21 @@ func treesitterRule(r *rule, prec int) *treesitter.Rule {
 			}
 			ts.Members = append(ts.Members, *sub)
 		}
+		if len(ts.Members) == 0 {
+			return nil
+		}
 	case *labeledExpr:
-		ts.Type = treesitter.RuleTypeField
-		ts.Name = treesitter.Name(t.label)
-		ts.Content = treesitterRule(&rule{
+		sub := treesitterRule(&rule{
 			expr: t.expr,
 		}, prec)
+		if sub == nil {
+			return nil
+		}
+		ts.Type = treesitter.RuleTypeField
+		ts.Name = treesitter.Name(t.label)
+		ts.Content = sub
 	case *ruleRefExpr:
-		if t.name == "_" {
-			// ignore whitespace; tree-sitter works differently
+		if treesitterIgnored[t.name] {
 			return nil
 		}
 		ts.Type = treesitter.RuleTypeSymbol
@@ -97,14 +145,8 @@ func treesitterRule(r *rule, prec int) *treesitter.Rule {
 		ts.Type = treesitter.RuleTypeString
 		ts.Value = string(t.val)
 	case *andExpr:
-		sub := treesitterRule(&rule{
-			expr: t.expr,
-		}, prec)
-		if sub == nil {
-			return nil
-		}
-		ts.Type = treesitter.RuleTypeRepeat
-		ts.Content = sub
+		// lookahead; ignored
+		return nil
 	case *oneOrMoreExpr:
 		sub := treesitterRule(&rule{
 			expr: t.expr,
@@ -152,14 +194,15 @@ func treesitterRule(r *rule, prec int) *treesitter.Rule {
 	case *notExpr:
 		// ignored
 		return nil
+	case *andCodeExpr:
+		// ignored
+		return nil
 	// case *throwExpr:
 	// 	// ignored
 	// case *recoveryExpr:
 	// 	// ignored
 	// case *stateCodeExpr:
 	// 	// ignored
-	// case *andCodeExpr:
-	// 	// ignored
 	// case *notCodeExpr:
 	// 	// ignored
 	default:
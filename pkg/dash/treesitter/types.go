@@ -12,6 +12,7 @@ type Grammar struct {
 	Word       RuleName                    `json:"word"`
 	Rules      *OrderedMap[RuleName, Rule] `json:"rules"`
 	Extras     []Rule                      `json:"extras"`
+	Externals  []Rule                      `json:"externals,omitempty"`
 	Supertypes []string                    `json:"supertypes"`
 }
 
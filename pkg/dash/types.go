@@ -11,16 +11,20 @@ type Type = hm.Type
 
 type TypeNode interface {
 	hm.Inferer
+	Located
 }
 
 // TODO: support sub-selections?
 
 type NamedTypeNode struct {
 	Named string
+	Loc   *SourceLocation
 }
 
 var _ TypeNode = NamedTypeNode{}
 
+func (t NamedTypeNode) GetSourceLocation() *SourceLocation { return t.Loc }
+
 type UnresolvedTypeError struct {
 	Name string
 }
@@ -30,22 +34,30 @@ func (e UnresolvedTypeError) Error() string {
 }
 
 func (t NamedTypeNode) Infer(env hm.Env, fresh hm.Fresher) (hm.Type, error) {
-	if t.Named == "" {
-		return nil, fmt.Errorf("NamedType.Infer: empty name")
-	}
-	s, ok := env.(*Module).NamedType(t.Named)
-	if !ok {
+	return WithInferErrorHandling(t, func() (hm.Type, error) {
+		if t.Named == "" {
+			return nil, fmt.Errorf("NamedType.Infer: empty name")
+		}
+		s, ok := env.(*Module).NamedType(t.Named)
+		if ok {
+			return s, nil
+		}
+		if r, ok := env.(*Module).NamedRecord(t.Named); ok {
+			return r, nil
+		}
 		return nil, UnresolvedTypeError{t.Named}
-	}
-	return s, nil
+	})
 }
 
 type ListTypeNode struct {
 	Elem TypeNode
+	Loc  *SourceLocation
 }
 
 var _ TypeNode = ListTypeNode{}
 
+func (t ListTypeNode) GetSourceLocation() *SourceLocation { return t.Loc }
+
 func (t ListTypeNode) Infer(env hm.Env, fresh hm.Fresher) (hm.Type, error) {
 	e, err := t.Elem.Infer(env, fresh)
 	if err != nil {
@@ -93,9 +105,22 @@ func (t ListType) Eq(other Type) bool {
 	return false
 }
 
+// RecordType is the type of a record. Named records are input objects from the
+// schema; like modules, they are nominal, and never contain type variables.
 type RecordType struct {
 	Named  string
 	Fields []Keyed[*hm.Scheme] // TODO this should be a map
+
+	// Defaults is the set of fields that have a default value, and so may be
+	// omitted even if they're non-null.
+	Defaults Set[string]
+
+	// Docs is the description of each field that has one.
+	Docs map[string]string
+
+	// GraphQLNames is the name in the schema of each field that came from one,
+	// e.g. container-id for containerID.
+	GraphQLNames map[string]string
 }
 
 var _ hm.Type = (*RecordType)(nil)
@@ -120,14 +145,21 @@ func (t *RecordType) SchemeOf(key string) (*hm.Scheme, bool) {
 }
 
 func (t *RecordType) Clone() hm.Env {
-	retVal := new(RecordType)
-	ts := make([]Keyed[*hm.Scheme], len(t.Fields))
-	for i, tt := range t.Fields {
-		ts[i] = tt
-		ts[i].Value = ts[i].Value.Clone()
+	return cloneType(t).(*RecordType)
+}
+
+// DocOf returns the description of a field.
+func (t *RecordType) DocOf(key string) (string, bool) {
+	doc, ok := t.Docs[key]
+	return doc, ok
+}
+
+// GraphQLName returns the name of a field in the schema.
+func (t *RecordType) GraphQLName(key string) string {
+	if name, ok := t.GraphQLNames[key]; ok {
+		return name
 	}
-	retVal.Fields = ts
-	return retVal
+	return CamelCase(key)
 }
 
 func (t *RecordType) Add(key string, type_ *hm.Scheme) hm.Env {
@@ -145,15 +177,36 @@ func (t *RecordType) Remove(key string) hm.Env {
 }
 
 func (t *RecordType) Apply(subs hm.Subs) hm.Substitutable {
-	fields := make([]Keyed[*hm.Scheme], len(t.Fields))
-	for i, v := range t.Fields {
-		fields[i] = v
-		fields[i].Value = v.Value.Apply(subs).(*hm.Scheme)
+	if t.Named != "" {
+		return t
+	}
+	// schemes are substituted in place, so substitute a copy
+	rt := cloneType(t).(*RecordType)
+	for _, v := range rt.Fields {
+		v.Value.Apply(subs)
 	}
-	return NewRecordType(t.Named, fields...)
+	return rt
+}
+
+// Optional returns whether a field may be omitted, i.e. it is nullable or has
+// a default value.
+func (t *RecordType) Optional(key string) bool {
+	if _, hasDefault := t.Defaults[key]; hasDefault {
+		return true
+	}
+	scheme, found := t.SchemeOf(key)
+	if !found {
+		return false
+	}
+	ft, _ := scheme.Type()
+	_, nonNull := ft.(NonNullType)
+	return !nonNull
 }
 
 func (t *RecordType) FreeTypeVar() hm.TypeVarSet {
+	if t.Named != "" {
+		return nil
+	}
 	var tvs hm.TypeVarSet
 	for _, v := range t.Fields {
 		tvs = v.Value.FreeTypeVar().Union(tvs)
@@ -169,24 +222,37 @@ func (t *RecordType) Name() string {
 }
 
 func (t *RecordType) Normalize(k, v hm.TypeVarSet) (Type, error) {
+	if t.Named != "" {
+		return t, nil
+	}
 	cp := t.Clone().(*RecordType)
-	for _, f := range cp.Fields {
-		if err := f.Value.Normalize(); err != nil {
+	for i, f := range cp.Fields {
+		ft, mono := f.Value.Type()
+		if !mono {
+			// bound variables are local to the field
+			if err := f.Value.Normalize(); err != nil {
+				return nil, fmt.Errorf("RecordType.Normalize: %w", err)
+			}
+			continue
+		}
+		nt, err := ft.Normalize(k, v)
+		if err != nil {
 			return nil, fmt.Errorf("RecordType.Normalize: %w", err)
 		}
+		cp.Fields[i].Value = hm.NewScheme(nil, nt)
 	}
 	return cp, nil
 }
 
 func (t *RecordType) Types() hm.Types {
 	ts := hm.BorrowTypes(len(t.Fields))
-	for _, f := range t.Fields {
+	for i, f := range t.Fields {
 		t, mono := f.Value.Type()
 		if !mono {
 			// TODO maybe omit?
 			panic("RecordType.Types: non-monomorphic type")
 		}
-		ts = append(ts, t)
+		ts[i] = t
 	}
 	return ts
 }
@@ -196,6 +262,9 @@ func (t *RecordType) Eq(other Type) bool {
 		if len(ot.Fields) != len(t.Fields) {
 			return false
 		}
+		if t.Named != "" && t.Named == ot.Named {
+			return true
+		}
 		if t.Named != "" && ot.Named != "" && t.Named != ot.Named {
 			// if either does not specify a name, allow a match
 			//
@@ -229,7 +298,11 @@ func (t *RecordType) Format(f fmt.State, c rune) {
 	}
 	f.Write([]byte("{"))
 	for i, v := range t.Fields {
-		fmt.Fprintf(f, "%s: %v", v.Key, v.Value)
+		if t, mono := v.Value.Type(); mono {
+			fmt.Fprintf(f, "%s: %v", v.Key, t)
+		} else {
+			fmt.Fprintf(f, "%s: %v", v.Key, v.Value)
+		}
 		if i < len(t.Fields)-1 {
 			fmt.Fprintf(f, ", ")
 		}
@@ -241,10 +314,13 @@ func (t *RecordType) String() string { return fmt.Sprintf("%v", t) }
 
 type NonNullTypeNode struct {
 	Elem TypeNode
+	Loc  *SourceLocation
 }
 
 var _ TypeNode = NonNullTypeNode{}
 
+func (t NonNullTypeNode) GetSourceLocation() *SourceLocation { return t.Loc }
+
 func (t NonNullTypeNode) Infer(env hm.Env, fresh hm.Fresher) (hm.Type, error) {
 	e, err := t.Elem.Infer(env, fresh)
 	if err != nil {
@@ -255,13 +331,213 @@ func (t NonNullTypeNode) Infer(env hm.Env, fresh hm.Fresher) (hm.Type, error) {
 
 type VariableTypeNode struct {
 	Name byte
+	Loc  *SourceLocation
 }
 
 var _ TypeNode = VariableTypeNode{}
 
+func (t VariableTypeNode) GetSourceLocation() *SourceLocation { return t.Loc }
+
 func (t VariableTypeNode) Infer(env hm.Env, fresh hm.Fresher) (hm.Type, error) {
-	// TODO unsure if this works
-	return hm.TypeVariable(t.Name), nil
+	return rigidVar(t.Name), nil
+}
+
+// rigidVar is a type variable written in a signature, like the a in
+// id(x: a): a. Within the definition it stands for whatever type the caller
+// picks, so it can't be unified with a specific type; each use of the slot
+// instantiates it afresh.
+type rigidVar rune
+
+var _ hm.Type = rigidVar(0)
+
+func (t rigidVar) Name() string { return string(t) }
+
+func (t rigidVar) Apply(subs hm.Subs) hm.Substitutable {
+	if subs == nil {
+		return t
+	}
+	if sub, ok := subs.Get(hm.TypeVariable(t)); ok {
+		return sub
+	}
+	return t
+}
+
+func (t rigidVar) FreeTypeVar() hm.TypeVarSet {
+	return hm.TypeVarSet{hm.TypeVariable(t)}
+}
+
+func (t rigidVar) Normalize(k, v hm.TypeVarSet) (Type, error) {
+	if i := k.Index(hm.TypeVariable(t)); i != -1 {
+		return rigidVar(v[i]), nil
+	}
+	return nil, fmt.Errorf("rigidVar.Normalize: %s not in %v", t, k)
+}
+
+func (t rigidVar) Types() hm.Types { return nil }
+
+func (t rigidVar) String() string { return string(t) }
+
+func (t rigidVar) Format(s fmt.State, c rune) { fmt.Fprintf(s, "%c", rune(t)) }
+
+func (t rigidVar) Eq(other Type) bool { return other == t }
+
+// cloneType returns a deep copy of a type. Function types and schemes are
+// substituted in place, so types are copied before being substituted to avoid
+// changing the types bound in an env.
+func cloneType(t hm.Type) hm.Type {
+	switch t := t.(type) {
+	case *hm.FunctionType:
+		return hm.NewFnType(cloneType(t.Arg()), cloneType(t.Ret(false)))
+	case *RecordType:
+		if t.Named != "" {
+			// nothing to substitute
+			return t
+		}
+		fields := make([]Keyed[*hm.Scheme], len(t.Fields))
+		for i, f := range t.Fields {
+			ft, _ := f.Value.Type()
+			bound := ft.FreeTypeVar().Difference(f.Value.FreeTypeVar())
+			fields[i] = Keyed[*hm.Scheme]{f.Key, hm.NewScheme(bound, cloneType(ft))}
+		}
+		rt := NewRecordType(t.Named, fields...)
+		rt.Defaults = t.Defaults
+		rt.Docs = t.Docs
+		rt.GraphQLNames = t.GraphQLNames
+		return rt
+	case NonNullType:
+		return NonNullType{cloneType(t.Type)}
+	case ListType:
+		return ListType{cloneType(t.Type)}
+	default:
+		return t
+	}
+}
+
+// applyType substitutes type variables in t without changing t.
+func applyType(subs hm.Subs, t hm.Type) hm.Type {
+	if subs == nil {
+		return t
+	}
+	return cloneType(t).Apply(subs).(hm.Type)
+}
+
+// widen returns the type that a value of the given type is converted to when
+// it is used where the expected type is wanted, following GraphQL's input
+// coercion rules: a T! is accepted where a T is expected, Ints widen to Floats,
+// list elements are widened likewise, and a single value is accepted where a
+// list of them is expected. Other types are left as they are, so that
+// unifying them reports the mismatch.
+func widen(expected, given hm.Type) hm.Type {
+	if isVar(expected) || isVar(given) {
+		return given
+	}
+
+	expectedNN, nonNullExpected := expected.(NonNullType)
+	givenNN, nonNullGiven := given.(NonNullType)
+
+	if nonNullGiven && !isVar(givenNN.Type) {
+		_, expectedList := stripNonNull(expected).(ListType)
+		_, givenList := givenNN.Type.(ListType)
+		if expectedList && !givenList {
+			// e.g. "foo" passed as [String!]!
+			givenNN = NonNullType{ListType{given}}
+		}
+	}
+
+	switch {
+	case nonNullExpected && nonNullGiven:
+		return NonNullType{widenValue(expectedNN.Type, givenNN.Type)}
+	case nonNullGiven:
+		return widenValue(expected, givenNN.Type)
+	case nonNullExpected:
+		// a nullable value can't be passed as non-null
+		return given
+	default:
+		return widenValue(expected, given)
+	}
+}
+
+// widenValue is widen for types without their nullability.
+func widenValue(expected, given hm.Type) hm.Type {
+	switch et := expected.(type) {
+	case ListType:
+		if gt, ok := given.(ListType); ok {
+			return ListType{widen(et.Type, gt.Type)}
+		}
+	case *Module:
+		// scalars are created per env, so they're compared by name
+		if gt, ok := given.(*Module); ok {
+			switch {
+			case et.Named == "Float" && gt.Named == "Int":
+				return et
+			case et.Named == "String" && gt.Named == "DirPath":
+				// directory paths are passed as strings
+				return et
+			}
+		}
+	case *RecordType:
+		if gt, ok := given.(*RecordType); ok && et.Named != "" && gt.Named == "" && conforms(et, gt) {
+			return et
+		}
+	}
+	return given
+}
+
+// conforms returns whether a record can be passed as an input object: each of
+// its fields is a field of the input object with a matching type, and the
+// fields it leaves out are optional.
+func conforms(input, given *RecordType) bool {
+	for _, f := range given.Fields {
+		expected, found := input.SchemeOf(f.Key)
+		if !found {
+			return false
+		}
+		et, _ := expected.Type()
+		gt, _ := f.Value.Type()
+		if !unifiable(et, widen(et, gt)) {
+			return false
+		}
+	}
+	for _, f := range input.Fields {
+		if _, found := given.SchemeOf(f.Key); !found && !input.Optional(f.Key) {
+			return false
+		}
+	}
+	return true
+}
+
+// stripNonNull returns the nullable form of a type.
+func stripNonNull(t hm.Type) hm.Type {
+	if nn, ok := t.(NonNullType); ok {
+		return nn.Type
+	}
+	return t
+}
+
+// isVar returns whether a type is a type variable, i.e. not yet known or
+// chosen by the caller.
+func isVar(t hm.Type) bool {
+	switch t.(type) {
+	case hm.TypeVariable, rigidVar:
+		return true
+	default:
+		return false
+	}
+}
+
+// knownType is a TypeNode for a type that is already known, e.g. the type of
+// an argument of a lambda, determined from where the lambda is passed.
+type knownType struct {
+	Type hm.Type
+	Loc  *SourceLocation
+}
+
+var _ TypeNode = knownType{}
+
+func (t knownType) GetSourceLocation() *SourceLocation { return t.Loc }
+
+func (t knownType) Infer(env hm.Env, fresh hm.Fresher) (hm.Type, error) {
+	return t.Type, nil
 }
 
 type NonNullType struct {
@@ -306,11 +582,16 @@ func (t NonNullType) Eq(other Type) bool {
 type FunTypeNode struct {
 	Args []SlotDecl
 	Ret  TypeNode
+	Loc  *SourceLocation
 }
 
 var _ TypeNode = FunTypeNode{}
 
+func (t FunTypeNode) GetSourceLocation() *SourceLocation { return t.Loc }
+
 func (t FunTypeNode) Infer(env hm.Env, fresh hm.Fresher) (hm.Type, error) {
+	defaults := Set[string]{}
+	docs := map[string]string{}
 	args := make([]Keyed[*hm.Scheme], len(t.Args))
 	for i, a := range t.Args {
 		// TODO: more scheme/type awkwardness, double check this
@@ -324,12 +605,21 @@ func (t FunTypeNode) Infer(env hm.Env, fresh hm.Fresher) (hm.Type, error) {
 		}
 		// TODO: should we infer from value?
 		args[i] = Keyed[*hm.Scheme]{Key: a.Named, Value: hm.NewScheme(nil, dt)}
+		if a.Value != nil {
+			defaults[a.Named] = struct{}{}
+		}
+		if a.Description != "" {
+			docs[a.Named] = a.Description
+		}
 	}
 	ret, err := t.Ret.Infer(env, fresh)
 	if err != nil {
 		return nil, fmt.Errorf("FunTypeNode.Infer: %w", err)
 	}
-	return hm.NewFnType(NewRecordType("", args...), ret), nil
+	rt := NewRecordType("", args...)
+	rt.Defaults = defaults
+	rt.Docs = docs
+	return hm.NewFnType(rt, ret), nil
 }
 
 // not needed yet
@@ -2,9 +2,13 @@ package dash
 
 func sliceOf[T any](val any) []T {
 	anys := val.([]any)
-	ts := make([]T, len(anys))
-	for i, node := range anys {
-		ts[i] = node.(T)
+	ts := make([]T, 0, len(anys))
+	for _, node := range anys {
+		if node == nil {
+			// skipped by error recovery
+			continue
+		}
+		ts = append(ts, node.(T))
 	}
 	return ts
 }
@@ -0,0 +1,62 @@
+package dash
+
+import "testing"
+
+func TestWith(t *testing.T) {
+	runCheckCases(t, []checkCase{
+		{
+			Name: "function",
+			Src: `pub go-cache(c: Container!): Container! {
+  c.with-env-variable(name: "GOCACHE", value: "/cache")
+}
+pub c: Container! = container.from("golang").with(go-cache).with-exec(["go", "build"])`,
+		},
+		{
+			Name: "lambda",
+			Src:  `pub c: Container! = container.from("golang").with(fn(c) -> c.with-exec(["go", "version"]))`,
+		},
+		{
+			Name: "named argument",
+			Src:  `pub c = container.with(f: fn(c: Container!): Container! { c })`,
+		},
+		{
+			Name: "closure",
+			Src: `pub with-env(name: String!, value: String!): fn(c: Container!): Container! {
+  fn(c: Container!) -> c.with-env-variable(name, value)
+}
+pub c = container.from("alpine").with(with-env("A", "B")).with(with-env("C", "D"))`,
+		},
+		{
+			Name: "function of another type",
+			Src: `pub upper(s: String!): String! { s }
+pub c = container.with(upper)`,
+			Err: "Container ~ String",
+		},
+		{
+			Name: "function returning another type",
+			Src:  `pub c = container.with(fn(c: Container!) -> c.stdout)`,
+			Err:  "Container ~ String",
+		},
+		{
+			Name: "function returning nullable",
+			Src: `pub maybe: Container = null
+pub c = container.with(fn(c: Container!) -> maybe)`,
+			Err: `"f" cannot unify`,
+		},
+		{
+			Name: "function of too many arguments",
+			Src:  `pub c = container.with(fn(c: Container!, d: Container!) -> c)`,
+			Err:  `"f" cannot unify`,
+		},
+		{
+			Name: "not a function",
+			Src:  `pub c = container.with(container)`,
+			Err:  `"f" cannot unify`,
+		},
+		{
+			Name: "query has no with",
+			Src:  `pub c = with(fn(c) -> c)`,
+			Err:  `"with" not found`,
+		},
+	})
+}
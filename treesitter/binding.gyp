@@ -9,6 +9,7 @@
       "sources": [
         "bindings/node/binding.cc",
         "src/parser.c",
+        "src/scanner.c",
       ],
       "cflags_c": [
         "-std=c99",
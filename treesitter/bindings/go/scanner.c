@@ -0,0 +1 @@
+../../src/scanner.c
\ No newline at end of file
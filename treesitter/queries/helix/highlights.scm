@@ -1,5 +1,7 @@
 ;; Includes
 
+[(import_token) (as_token)] @keyword.control.import
+
 ((symbol) @keyword.control.import
   (#match? @keyword.control.import "^(use|import|load)$"))
 
@@ -14,11 +16,30 @@
 
 ;; Operators
 
+[
+  (or_token)
+  (and_token)
+  (eq_token)
+  (neq_token)
+  (le_token)
+  (ge_token)
+  (lt_token)
+  (gt_token)
+  (plus_token)
+  (minus_token)
+  (star_token)
+  (slash_token)
+  (not_token)
+  (interro_token)
+] @operator
+
 ; TODO: classify
 ((symbol) @operator (#match? @operator "^(&|\\*|\\+|-|<|<=|=|>|>=)$"))
 
 ;; Defining
 
+(fn_token) @keyword.function
+
 ((list
   . (symbol) @label
   . (symbol) @function
@@ -44,6 +65,8 @@
 
 ;; Conditionals
 
+[(if_token) (else_token) (case_token)] @keyword.control.conditional
+
 ((symbol) @keyword.control.conditional
   (#match? @keyword.control.conditional "^(if|case|cond|when)$"))
 
@@ -95,6 +118,8 @@
 
 ;; Punctuation
 
+(arrow_token) @punctuation.delimiter
+
 [ "(" ")" ] @punctuation.bracket
 
 [ "{" "}" ] @punctuation.bracket
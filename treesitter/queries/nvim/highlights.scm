@@ -25,6 +25,8 @@
 
 ;; Includes
 
+[(import_token) (as_token)] @include
+
 ((symbol) @include
   (#any-of? @include "use" "import" "load"))
 
@@ -35,6 +37,8 @@
 
 ;; Defining
 
+(fn_token) @keyword.function
+
 ((list
   . (symbol) @keyword.function
   . (symbol) @function
@@ -60,6 +64,8 @@
 
 ;; Conditionals
 
+[(if_token) (else_token) (case_token)] @conditional
+
 ((symbol) @conditional
   (#any-of? @conditional "if" "case" "cond" "when"))
 
@@ -70,6 +76,23 @@
 
 ;; Operators
 
+[
+  (or_token)
+  (and_token)
+  (eq_token)
+  (neq_token)
+  (le_token)
+  (ge_token)
+  (lt_token)
+  (gt_token)
+  (plus_token)
+  (minus_token)
+  (star_token)
+  (slash_token)
+  (not_token)
+  (interro_token)
+] @operator
+
 ; TODO: classify
 ((symbol) @operator (#any-of? @operator "&" "*" "+" "-" "<" "<=" "=" ">" ">="))
 
@@ -95,6 +118,8 @@
 
 ;; Punctuation
 
+(arrow_token) @punctuation.delimiter
+
 [ "(" ")" ] @punctuation.bracket
 
 [ "{" "}" ] @punctuation.bracket
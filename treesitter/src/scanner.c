@@ -0,0 +1,157 @@
+#include "tree_sitter/parser.h"
+
+#include <stdbool.h>
+#include <string.h>
+
+// External scanner for the newlines that terminate forms.
+//
+// A form is terminated by a newline unless the next line continues it, which
+// tree-sitter's lexer can't tell on its own since it would have to look past
+// the newline without consuming what follows. The continuations match the
+// places where the PEG grammar allows a newline after a complete form:
+//
+//   foo
+//     .bar            # Select
+//     $ echo hi;      # Exec
+//   import "foo"
+//     as bar          # Import
+//
+// Commas and semicolons are left to the grammar, and a form that is followed by
+// '}' or the end of input is terminated by an empty token.
+//
+// An else on the next line continues a conditional, but only where the grammar
+// expects one, since it may also begin the next arm of a case:
+//
+//   if x { 1 }
+//   else { 2 }        # Conditional
+//   case p {
+//     TCP -> 1
+//     else -> 2       # CaseArm
+//   }
+//
+// The scanner matches the separator before such an else instead of a newline.
+
+enum TokenType {
+  EOL_TOKEN,
+  ELSE_SEPARATOR,
+  ERROR_SENTINEL,
+};
+
+void *tree_sitter_dash_external_scanner_create(void) { return NULL; }
+
+void tree_sitter_dash_external_scanner_destroy(void *payload) {}
+
+unsigned tree_sitter_dash_external_scanner_serialize(void *payload,
+                                                     char *buffer) {
+  return 0;
+}
+
+void tree_sitter_dash_external_scanner_deserialize(void *payload,
+                                                   const char *buffer,
+                                                   unsigned length) {}
+
+static void advance(TSLexer *lexer) { lexer->advance(lexer, false); }
+
+static void skip(TSLexer *lexer) { lexer->advance(lexer, true); }
+
+static bool is_word_char(int32_t c) {
+  return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') ||
+         (c >= '0' && c <= '9') || c == '_' || c == '-';
+}
+
+// skip_blank skips whitespace, newlines, and comments.
+static void skip_blank(TSLexer *lexer) {
+  for (;;) {
+    switch (lexer->lookahead) {
+    case ' ':
+    case '\t':
+    case '\r':
+    case '\n':
+      skip(lexer);
+      break;
+    case '#':
+      while (lexer->lookahead != '\n' && !lexer->eof(lexer)) {
+        skip(lexer);
+      }
+      break;
+    default:
+      return;
+    }
+  }
+}
+
+// scan_keyword consumes word and returns whether it is not just the start of
+// a longer word.
+static bool scan_keyword(TSLexer *lexer, const char *word) {
+  for (size_t i = 0; i < strlen(word); i++) {
+    if (lexer->lookahead != word[i]) {
+      return false;
+    }
+    advance(lexer);
+  }
+  return !is_word_char(lexer->lookahead);
+}
+
+// continues returns whether the next line continues the current form.
+static bool continues(TSLexer *lexer) {
+  switch (lexer->lookahead) {
+  case '.':
+    advance(lexer);
+    // ./ and ../ begin a path, not a selection
+    return lexer->lookahead != '/' && lexer->lookahead != '.';
+  case '$':
+    return true;
+  case 'a':
+    return scan_keyword(lexer, "as");
+  default:
+    return false;
+  }
+}
+
+bool tree_sitter_dash_external_scanner_scan(void *payload, TSLexer *lexer,
+                                            const bool *valid_symbols) {
+  // everything is valid during error recovery; leave it to the grammar
+  if (valid_symbols[ERROR_SENTINEL] ||
+      (!valid_symbols[EOL_TOKEN] && !valid_symbols[ELSE_SEPARATOR])) {
+    return false;
+  }
+
+  while (lexer->lookahead == ' ' || lexer->lookahead == '\t' ||
+         lexer->lookahead == '\r') {
+    skip(lexer);
+  }
+
+  lexer->result_symbol = EOL_TOKEN;
+
+  if (valid_symbols[EOL_TOKEN] &&
+      (lexer->eof(lexer) || lexer->lookahead == '}')) {
+    lexer->mark_end(lexer);
+    return true;
+  }
+
+  bool newline = lexer->lookahead == '\n';
+  if (newline) {
+    advance(lexer);
+  }
+  lexer->mark_end(lexer);
+
+  skip_blank(lexer);
+
+  if (valid_symbols[ELSE_SEPARATOR] && lexer->lookahead == 'e') {
+    if (scan_keyword(lexer, "else")) {
+      skip_blank(lexer);
+      // else -> begins a case arm instead
+      if (lexer->lookahead != '-') {
+        lexer->result_symbol = ELSE_SEPARATOR;
+        return true;
+      }
+    }
+    return newline && valid_symbols[EOL_TOKEN];
+  }
+
+  if (!newline || !valid_symbols[EOL_TOKEN]) {
+    return false;
+  }
+
+  return !continues(lexer);
+}